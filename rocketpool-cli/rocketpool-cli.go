@@ -98,6 +98,10 @@ ______           _        _    ______           _
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
 		},
+		cli.BoolFlag{
+			Name:  "strict-config",
+			Usage: "Fail if the Smartnode config file has any unrecognized settings, instead of ignoring them",
+		},
 		cli.BoolFlag{
 			Name: "secure-session, s",
 			Usage: "Some commands may print sensitive information to your terminal. " +
@@ -130,7 +134,7 @@ ______           _        _    ______           _
 	// Stop if the config file doesn't exist yet
 	_, err = os.Stat(expandedPath)
 	if !os.IsNotExist(err) {
-		cfg, err := rp.LoadConfigFromFile(expandedPath)
+		cfg, _, err := rp.LoadConfigFromFile(expandedPath, false)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to load the global config file: %s\n", err.Error())
 			os.Exit(1)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/rocket-pool/smartnode/shared/services/config"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -49,7 +50,7 @@ func createParameterizedFormItems(params []*cfgtypes.Parameter, descriptionBox *
 		case cfgtypes.ParameterType_Choice:
 			item = createParameterizedDropDown(param, descriptionBox)
 		case cfgtypes.ParameterType_Float:
-			item = createParameterizedStringField(param)
+			item = createParameterizedFloatField(param)
 		default:
 			panic(fmt.Sprintf("Unknown parameter type %v", param))
 		}
@@ -94,6 +95,9 @@ func createParameterizedIntField(param *cfgtypes.Parameter) *parameterizedFormIt
 			item.SetText("")
 		} else {
 			value, err := strconv.ParseInt(item.GetText(), 0, 0)
+			if err == nil {
+				err = param.Validate(value)
+			}
 			if err != nil {
 				// TODO: show error modal?
 				item.SetText("")
@@ -129,6 +133,9 @@ func createParameterizedUintField(param *cfgtypes.Parameter) *parameterizedFormI
 			item.SetText("")
 		} else {
 			value, err := strconv.ParseUint(item.GetText(), 0, 0)
+			if err == nil {
+				err = param.Validate(value)
+			}
 			if err != nil {
 				// TODO: show error modal?
 				item.SetText("")
@@ -164,6 +171,9 @@ func createParameterizedUint16Field(param *cfgtypes.Parameter) *parameterizedFor
 			item.SetText("")
 		} else {
 			value, err := strconv.ParseUint(item.GetText(), 0, 16)
+			if err == nil {
+				err = param.Validate(value)
+			}
 			if err != nil {
 				// TODO: show error modal?
 				item.SetText("")
@@ -198,6 +208,7 @@ func createParameterizedStringField(param *cfgtypes.Parameter) *parameterizedFor
 			item.SetText("")
 		} else {
 			param.Value = item.GetText()
+			config.NormalizeContainerImage(param)
 		}
 	})
 	item.SetAcceptanceFunc(func(textToCheck string, lastChar rune) bool {
@@ -226,6 +237,44 @@ func createParameterizedStringField(param *cfgtypes.Parameter) *parameterizedFor
 	}
 }
 
+// Create a standard float field
+func createParameterizedFloatField(param *cfgtypes.Parameter) *parameterizedFormItem {
+	item := tview.NewInputField().
+		SetLabel(param.Name).
+		SetAcceptanceFunc(tview.InputFieldFloat)
+	item.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			item.SetText("")
+		} else {
+			value, err := strconv.ParseFloat(item.GetText(), 64)
+			if err == nil {
+				err = param.Validate(value)
+			}
+			if err != nil {
+				// TODO: show error modal?
+				item.SetText("")
+			} else {
+				param.Value = value
+			}
+		}
+	})
+	item.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyDown, tcell.KeyTab:
+			return tcell.NewEventKey(tcell.KeyTab, 0, 0)
+		case tcell.KeyUp, tcell.KeyBacktab:
+			return tcell.NewEventKey(tcell.KeyBacktab, 0, 0)
+		default:
+			return event
+		}
+	})
+
+	return &parameterizedFormItem{
+		parameter: param,
+		item:      item,
+	}
+}
+
 // Create a standard choice field
 func createParameterizedDropDown(param *cfgtypes.Parameter, descriptionBox *tview.TextView) *parameterizedFormItem {
 	// Create the list of options
@@ -73,9 +73,18 @@ func NewReviewPage(md *mainDisplay, oldConfig *config.RocketPoolConfig, newConfi
 			builder.WriteString("<No changes>")
 		} else {
 			builder.WriteString("The following containers must be restarted for these changes to take effect:")
-			for container, _ := range totalAffectedContainers {
+			containersToRestart = config.GetSortedContainers(totalAffectedContainers)
+			for _, container := range containersToRestart {
 				builder.WriteString(fmt.Sprintf("\n\t%v", container))
-				containersToRestart = append(containersToRestart, container)
+			}
+		}
+
+		// Show any warnings that don't prevent the config from being saved
+		warnings := newConfig.GetWarnings()
+		if len(warnings) > 0 {
+			builder.WriteString("\n\n[yellow]NOTE: Please review the following potential problems with your configuration:\n\n")
+			for _, warning := range warnings {
+				builder.WriteString(fmt.Sprintf("%s\n\n", warning))
 			}
 		}
 	}
@@ -20,6 +20,7 @@ type ConsensusConfigPage struct {
 	nimbusItems             []*parameterizedFormItem
 	prysmItems              []*parameterizedFormItem
 	tekuItems               []*parameterizedFormItem
+	lodestarItems           []*parameterizedFormItem
 	externalLighthouseItems []*parameterizedFormItem
 	externalPrysmItems      []*parameterizedFormItem
 	externalTekuItems       []*parameterizedFormItem
@@ -86,6 +87,7 @@ func (configPage *ConsensusConfigPage) createContent() {
 	configPage.nimbusItems = createParameterizedFormItems(configPage.masterConfig.Nimbus.GetParameters(), configPage.layout.descriptionBox)
 	configPage.prysmItems = createParameterizedFormItems(configPage.masterConfig.Prysm.GetParameters(), configPage.layout.descriptionBox)
 	configPage.tekuItems = createParameterizedFormItems(configPage.masterConfig.Teku.GetParameters(), configPage.layout.descriptionBox)
+	configPage.lodestarItems = createParameterizedFormItems(configPage.masterConfig.Lodestar.GetParameters(), configPage.layout.descriptionBox)
 	configPage.externalLighthouseItems = createParameterizedFormItems(configPage.masterConfig.ExternalLighthouse.GetParameters(), configPage.layout.descriptionBox)
 	configPage.externalPrysmItems = createParameterizedFormItems(configPage.masterConfig.ExternalPrysm.GetParameters(), configPage.layout.descriptionBox)
 	configPage.externalTekuItems = createParameterizedFormItems(configPage.masterConfig.ExternalTeku.GetParameters(), configPage.layout.descriptionBox)
@@ -97,6 +99,7 @@ func (configPage *ConsensusConfigPage) createContent() {
 	configPage.layout.mapParameterizedFormItems(configPage.nimbusItems...)
 	configPage.layout.mapParameterizedFormItems(configPage.prysmItems...)
 	configPage.layout.mapParameterizedFormItems(configPage.tekuItems...)
+	configPage.layout.mapParameterizedFormItems(configPage.lodestarItems...)
 	configPage.layout.mapParameterizedFormItems(configPage.externalLighthouseItems...)
 	configPage.layout.mapParameterizedFormItems(configPage.externalPrysmItems...)
 	configPage.layout.mapParameterizedFormItems(configPage.externalTekuItems...)
@@ -169,6 +172,8 @@ func (configPage *ConsensusConfigPage) handleLocalCcChanged() {
 		configPage.layout.addFormItemsWithCommonParams(configPage.ccCommonItems, configPage.prysmItems, configPage.masterConfig.Prysm.UnsupportedCommonParams)
 	case cfgtypes.ConsensusClient_Teku:
 		configPage.layout.addFormItemsWithCommonParams(configPage.ccCommonItems, configPage.tekuItems, configPage.masterConfig.Teku.UnsupportedCommonParams)
+	case cfgtypes.ConsensusClient_Lodestar:
+		configPage.layout.addFormItemsWithCommonParams(configPage.ccCommonItems, configPage.lodestarItems, configPage.masterConfig.Lodestar.UnsupportedCommonParams)
 	}
 
 	configPage.layout.refresh()
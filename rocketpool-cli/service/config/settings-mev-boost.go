@@ -90,6 +90,7 @@ func (configPage *MevBoostConfigPage) createContent() {
 	localParams := []*cfgtypes.Parameter{
 		&configPage.masterConfig.MevBoost.Port,
 		&configPage.masterConfig.MevBoost.OpenRpcPort,
+		&configPage.masterConfig.MevBoost.MinBid,
 		&configPage.masterConfig.MevBoost.ContainerTag,
 		&configPage.masterConfig.MevBoost.AdditionalFlags,
 	}
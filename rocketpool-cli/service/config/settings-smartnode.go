@@ -78,6 +78,13 @@ func (configPage *SmartnodeConfigPage) createContent() {
 				configPage.home.md.Config.ChangeNetwork(newNetwork)
 				configPage.home.refresh()
 			})
+		} else if formItem.parameter.ID == config.UpdateChannelID {
+			dropDown := formItem.item.(*DropDown)
+			dropDown.SetSelectedFunc(func(text string, index int) {
+				newChannel := configPage.home.md.Config.Smartnode.UpdateChannel.Options[index].Value.(cfgtypes.UpdateChannel)
+				configPage.home.md.Config.ChangeUpdateChannel(newChannel)
+				configPage.home.refresh()
+			})
 		}
 	}
 	layout.refresh()
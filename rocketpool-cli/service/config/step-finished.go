@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/rocket-pool/smartnode/shared/services/config"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -93,10 +94,7 @@ func processConfigAfterQuit(md *mainDisplay) {
 			totalAffectedContainers[cfgtypes.ContainerID_Watchtower] = true
 		}
 
-		var containersToRestart []cfgtypes.ContainerID
-		for container := range totalAffectedContainers {
-			containersToRestart = append(containersToRestart, container)
-		}
+		containersToRestart := config.GetSortedContainers(totalAffectedContainers)
 
 		md.ShouldSave = true
 		md.ContainersToRestart = containersToRestart
@@ -935,6 +935,9 @@ func pruneExecutionClient(c *cli.Context) error {
 	case cfgtypes.ExecutionClient_Besu:
 		fmt.Println("You are using Besu as your Execution client.\nBesu does not need pruning.")
 		return nil
+	case cfgtypes.ExecutionClient_Erigon:
+		fmt.Println("You are using Erigon as your Execution client.\nErigon prunes itself automatically and does not need manual pruning.")
+		return nil
 	}
 
 	fmt.Println("This will shut down your main execution client and prune its database, freeing up disk space.")
@@ -1196,6 +1199,8 @@ func serviceVersion(c *cli.Context) error {
 			eth1ClientString = fmt.Sprintf(format, "Nethermind", cfg.Nethermind.ContainerTag.Value.(string))
 		case cfgtypes.ExecutionClient_Besu:
 			eth1ClientString = fmt.Sprintf(format, "Besu", cfg.Besu.ContainerTag.Value.(string))
+		case cfgtypes.ExecutionClient_Erigon:
+			eth1ClientString = fmt.Sprintf(format, "Erigon", cfg.Erigon.ContainerTag.Value.(string))
 		default:
 			return fmt.Errorf("unknown local execution client [%v]", eth1Client)
 		}
@@ -1224,6 +1229,8 @@ func serviceVersion(c *cli.Context) error {
 			eth2ClientString = fmt.Sprintf(format+"\n\tVC image: %s", "Prysm", cfg.Prysm.BnContainerTag.Value.(string), cfg.Prysm.VcContainerTag.Value.(string))
 		case cfgtypes.ConsensusClient_Teku:
 			eth2ClientString = fmt.Sprintf(format, "Teku", cfg.Teku.ContainerTag.Value.(string))
+		case cfgtypes.ConsensusClient_Lodestar:
+			eth2ClientString = fmt.Sprintf(format, "Lodestar", cfg.Lodestar.ContainerTag.Value.(string))
 		default:
 			return fmt.Errorf("unknown local consensus client [%v]", eth2Client)
 		}
@@ -0,0 +1,166 @@
+package node
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Config
+const (
+	backupFileFormat     string = "rp-validators-%s.tar.gz"
+	backupTimeFormat     string = "20060102-150405"
+	backupTempFileSuffix string = ".tmp"
+)
+
+// Back up validators task
+type backupValidators struct {
+	c          *cli.Context
+	log        log.ColorLogger
+	cfg        *config.RocketPoolConfig
+	nextBackup time.Time
+}
+
+// Create back up validators task
+func newBackupValidators(c *cli.Context, logger log.ColorLogger) (*backupValidators, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &backupValidators{
+		c:   c,
+		log: logger,
+		cfg: cfg,
+	}, nil
+
+}
+
+// Back up the validator keys and slashing protection databases if the next scheduled backup is due
+func (b *backupValidators) run() error {
+
+	// Check if automatic backups are enabled
+	interval, err := b.cfg.Smartnode.GetBackupInterval()
+	if err != nil {
+		return err
+	}
+	if interval == 0 {
+		return nil
+	}
+
+	// Wait for the next scheduled backup
+	now := time.Now()
+	if b.nextBackup.IsZero() {
+		b.nextBackup, err = b.cfg.Smartnode.NextBackupTime(now)
+		if err != nil {
+			return err
+		}
+		b.log.Printlnf("The next validator backup is scheduled for %s.", b.nextBackup.Format(time.RFC1123))
+		return nil
+	}
+	if now.Before(b.nextBackup) {
+		return nil
+	}
+
+	// Log
+	b.log.Println("Backing up validator keys and slashing protection...")
+
+	// Write the archive under a temporary name so a partial backup is never mistaken for a complete one
+	backupFolder := b.cfg.Smartnode.GetBackupFolder(true)
+	backupPath := filepath.Join(backupFolder, fmt.Sprintf(backupFileFormat, now.UTC().Format(backupTimeFormat)))
+	tempPath := backupPath + backupTempFileSuffix
+	err = writeBackupArchive(b.cfg.Smartnode.GetValidatorKeychainPath(), tempPath)
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("error backing up validators: %w", err)
+	}
+	err = os.Rename(tempPath, backupPath)
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("error saving validator backup: %w", err)
+	}
+
+	// Schedule the next backup
+	b.nextBackup, err = b.cfg.Smartnode.NextBackupTime(now)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	b.log.Printlnf("Saved validator backup to %s. The next backup is scheduled for %s.", backupPath, b.nextBackup.Format(time.RFC1123))
+	return nil
+
+}
+
+// Writes a gzipped tarball of a folder to the given path
+func writeBackupArchive(sourceFolder string, archivePath string) error {
+
+	file, err := os.OpenFile(archivePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = filepath.Walk(sourceFolder, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			// Skip sockets, symlinks, and other special files
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceFolder, path)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relativePath)
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		sourceFile, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer sourceFile.Close()
+		_, err = io.Copy(tarWriter, sourceFile)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+	return file.Sync()
+
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/docker/docker/client"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/urfave/cli"
 
@@ -91,12 +90,7 @@ func (m *manageFeeRecipient) run() error {
 	}
 
 	// Get the correct fee recipient address
-	var correctFeeRecipient common.Address
-	if feeRecipientInfo.IsInSmoothingPool || feeRecipientInfo.IsInOptOutCooldown {
-		correctFeeRecipient = feeRecipientInfo.SmoothingPoolAddress
-	} else {
-		correctFeeRecipient = feeRecipientInfo.FeeDistributorAddress
-	}
+	correctFeeRecipient := feeRecipientInfo.GetCorrectFeeRecipient()
 
 	// Check if the VC is using the correct fee recipient
 	fileExists, correctAddress, err := rpsvc.CheckFeeRecipientFile(correctFeeRecipient, m.cfg)
@@ -13,8 +13,8 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
-	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lodestar"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
@@ -33,6 +33,7 @@ const (
 	DownloadRewardsTreesColor    = color.FgGreen
 	MetricsColor                 = color.FgHiYellow
 	ManageFeeRecipientColor      = color.FgHiCyan
+	BackupValidatorsColor        = color.FgHiBlue
 	ErrorColor                   = color.FgRed
 	WarningColor                 = color.FgYellow
 )
@@ -85,6 +86,10 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	backupValidators, err := newBackupValidators(c, log.NewColorLogger(BackupValidatorsColor))
+	if err != nil {
+		return err
+	}
 
 	// Initialize loggers
 	errorLog := log.NewColorLogger(ErrorColor)
@@ -96,6 +101,11 @@ func run(c *cli.Context) error {
 	// Run task loop
 	go func() {
 		for {
+			// Run the scheduled backup, which doesn't need the clients to be synced
+			if err := backupValidators.run(); err != nil {
+				errorLog.Println(err)
+			}
+
 			// Check the EC status
 			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
@@ -173,15 +183,11 @@ func deployDefaultFeeRecipientFile(c *cli.Context) error {
 		}
 
 		// Create the file
-		var defaultFeeRecipientFileContents string
-		if cfg.IsNativeMode {
-			// Native mode needs an environment variable definition
-			defaultFeeRecipientFileContents = fmt.Sprintf("%s=%s", config.FeeRecipientEnvVar, cfg.Smartnode.GetRethAddress().Hex())
-		} else {
-			// Docker and Hybrid just need the address itself
-			defaultFeeRecipientFileContents = cfg.Smartnode.GetRethAddress().Hex()
+		defaultFeeRecipientFileContents, err := cfg.GenerateFeeRecipientFile(cfg.Smartnode.GetRethAddress())
+		if err != nil {
+			return fmt.Errorf("could not generate default fee recipient file: %w", err)
 		}
-		err := ioutil.WriteFile(feeRecipientPath, []byte(defaultFeeRecipientFileContents), 0664)
+		err = ioutil.WriteFile(feeRecipientPath, defaultFeeRecipientFileContents, 0664)
 		if err != nil {
 			return fmt.Errorf("could not write default fee recipient file to %s: %w", feeRecipientPath, err)
 		}
@@ -206,7 +212,7 @@ func removeLegacyFeeRecipientFiles(c *cli.Context) error {
 	validatorsFolder := cfg.Smartnode.GetValidatorKeychainPath()
 
 	// Remove the legacy files
-	keystoreDirs := []string{lighthouse.KeystoreDir, nimbus.KeystoreDir, prysm.KeystoreDir, teku.KeystoreDir}
+	keystoreDirs := []string{lighthouse.KeystoreDir, nimbus.KeystoreDir, prysm.KeystoreDir, teku.KeystoreDir, lodestar.KeystoreDir}
 	for _, keystoreDir := range keystoreDirs {
 		oldFile := filepath.Join(validatorsFolder, keystoreDir, legacyFeeRecipientFile)
 		_, err = os.Stat(oldFile)
@@ -33,8 +33,6 @@ import (
 // Settings
 const MinipoolBatchSize = 20
 const BlockStartOffset = 100000
-const ScrubSafetyDivider = 2
-const MinScrubSafetyTime = time.Duration(0) * time.Hour
 
 // Submit scrub minipools task
 type submitScrubMinipools struct {
@@ -565,10 +563,7 @@ func (t *submitScrubMinipools) checkSafetyScrub() error {
 	scrubPeriod := time.Duration(scrubPeriodUint) * time.Second
 
 	// Get the safety period where minipools can be scrubbed without a valid deposit
-	safetyPeriod := scrubPeriod / ScrubSafetyDivider
-	if safetyPeriod < MinScrubSafetyTime {
-		safetyPeriod = MinScrubSafetyTime
-	}
+	safetyPeriod := t.cfg.Smartnode.GetScrubSafetyPeriod(scrubPeriod)
 
 	for minipool := range t.it.minipools {
 		// Get the minipool's status
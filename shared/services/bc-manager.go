@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/fatih/color"
 	"github.com/rocket-pool/rocketpool-go/types"
@@ -371,6 +370,11 @@ func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 				m.primaryReady = false
 				return m.runFunction0(function)
 			}
+			if isRetryableError(err) && m.fallbackReady {
+				// If it's still connected but the request failed, retry just this request on the fallback
+				m.logger.Printlnf("WARNING: Primary Beacon client request failed (%s), retrying on fallback...", err.Error())
+				return m.runFallbackFunction0(function)
+			}
 			// If it's a different error, just return it
 			return err
 		}
@@ -379,26 +383,32 @@ func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 	}
 
 	if m.fallbackReady {
-		// Try to run the function on the fallback
-		err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return fmt.Errorf("all Beacon clients failed")
-			}
-
-			// If it's a different error, just return it
-			return err
-		}
-		// If there's no error, return the result
-		return nil
+		return m.runFallbackFunction0(function)
 	}
 
 	return fmt.Errorf("no Beacon clients were ready")
 }
 
+// Runs a function on the fallback client
+func (m *BeaconClientManager) runFallbackFunction0(function bcFunction0) error {
+
+	// Try to run the function on the fallback
+	err := function(m.fallbackBc)
+	if err != nil {
+		if m.isDisconnected(err) {
+			// If it's disconnected, log it and mark the fallback as unavailable
+			m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
+			m.fallbackReady = false
+			return fmt.Errorf("all Beacon clients failed")
+		}
+
+		// If it's a different error, just return it
+		return err
+	}
+	// If there's no error, return the result
+	return nil
+}
+
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, error) {
 
@@ -413,6 +423,11 @@ func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, e
 				m.primaryReady = false
 				return m.runFunction1(function)
 			}
+			if isRetryableError(err) && m.fallbackReady {
+				// If it's still connected but the request failed, retry just this request on the fallback
+				m.logger.Printlnf("WARNING: Primary Beacon client request failed (%s), retrying on fallback...", err.Error())
+				return m.runFallbackFunction1(function)
+			}
 			// If it's a different error, just return it
 			return nil, err
 		}
@@ -421,26 +436,32 @@ func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, e
 	}
 
 	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result, err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, fmt.Errorf("all Beacon clients failed")
-			}
-			// If it's a different error, just return it
-			return nil, err
-		}
-		// If there's no error, return the result
-		return result, nil
+		return m.runFallbackFunction1(function)
 	}
 
 	return nil, fmt.Errorf("no Beacon clients were ready")
 
 }
 
+// Runs a function on the fallback client
+func (m *BeaconClientManager) runFallbackFunction1(function bcFunction1) (interface{}, error) {
+
+	// Try to run the function on the fallback
+	result, err := function(m.fallbackBc)
+	if err != nil {
+		if m.isDisconnected(err) {
+			// If it's disconnected, log it and mark the fallback as unavailable
+			m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
+			m.fallbackReady = false
+			return nil, fmt.Errorf("all Beacon clients failed")
+		}
+		// If it's a different error, just return it
+		return nil, err
+	}
+	// If there's no error, return the result
+	return result, nil
+}
+
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, interface{}, error) {
 
@@ -455,6 +476,11 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 				m.primaryReady = false
 				return m.runFunction2(function)
 			}
+			if isRetryableError(err) && m.fallbackReady {
+				// If it's still connected but the request failed, retry just this request on the fallback
+				m.logger.Printlnf("WARNING: Primary Beacon client request failed (%s), retrying on fallback...", err.Error())
+				return m.runFallbackFunction2(function)
+			}
 			// If it's a different error, just return it
 			return nil, nil, err
 		}
@@ -463,27 +489,33 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 	}
 
 	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result1, result2, err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, nil, fmt.Errorf("all Beacon clients failed")
-			}
-			// If it's a different error, just return it
-			return nil, nil, err
-		}
-		// If there's no error, return the result
-		return result1, result2, nil
+		return m.runFallbackFunction2(function)
 	}
 
 	return nil, nil, fmt.Errorf("no Beacon clients were ready")
 
 }
 
-// Returns true if the error was a connection failure and a backup client is available
+// Runs a function on the fallback client
+func (m *BeaconClientManager) runFallbackFunction2(function bcFunction2) (interface{}, interface{}, error) {
+
+	// Try to run the function on the fallback
+	result1, result2, err := function(m.fallbackBc)
+	if err != nil {
+		if m.isDisconnected(err) {
+			// If it's disconnected, log it and mark the fallback as unavailable
+			m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
+			m.fallbackReady = false
+			return nil, nil, fmt.Errorf("all Beacon clients failed")
+		}
+		// If it's a different error, just return it
+		return nil, nil, err
+	}
+	// If there's no error, return the result
+	return result1, result2, nil
+}
+
+// Returns true if the error was a connection failure or timeout, so the client should be considered disconnected
 func (m *BeaconClientManager) isDisconnected(err error) bool {
-	return strings.Contains(err.Error(), "dial tcp")
+	return isConnectionError(err)
 }
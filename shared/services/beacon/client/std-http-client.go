@@ -56,6 +56,16 @@ func NewStandardHttpClient(providerAddress string) *StandardHttpClient {
 	}
 }
 
+// An error for a request that the beacon node responded to with an unexpected HTTP status
+type HttpStatusError struct {
+	StatusCode   int
+	ResponseBody string
+}
+
+func (e *HttpStatusError) Error() string {
+	return fmt.Sprintf("HTTP status %d; response body: '%s'", e.StatusCode, e.ResponseBody)
+}
+
 // Close the client connection
 func (c *StandardHttpClient) Close() error {
 	return nil
@@ -312,7 +322,7 @@ func (c *StandardHttpClient) GetValidatorSyncDuties(indices []uint64, epoch uint
 		return nil, fmt.Errorf("Could not get validator sync duties: %w", err)
 	}
 	if status != http.StatusOK {
-		return nil, fmt.Errorf("Could not get validator sync duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return nil, fmt.Errorf("Could not get validator sync duties: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 
 	var response SyncDutiesResponse
@@ -346,7 +356,7 @@ func (c *StandardHttpClient) GetValidatorProposerDuties(indices []uint64, epoch
 		return nil, fmt.Errorf("Could not get validator proposer duties: %w", err)
 	}
 	if status != http.StatusOK {
-		return nil, fmt.Errorf("Could not get validator proposer duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return nil, fmt.Errorf("Could not get validator proposer duties: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 
 	var response ProposerDutiesResponse
@@ -557,7 +567,7 @@ func (c *StandardHttpClient) getSyncStatus() (SyncStatusResponse, error) {
 		return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: %w", err)
 	}
 	if status != http.StatusOK {
-		return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return SyncStatusResponse{}, fmt.Errorf("Could not get node sync status: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var syncStatus SyncStatusResponse
 	if err := json.Unmarshal(responseBody, &syncStatus); err != nil {
@@ -573,7 +583,7 @@ func (c *StandardHttpClient) getEth2Config() (Eth2ConfigResponse, error) {
 		return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %w", err)
 	}
 	if status != http.StatusOK {
-		return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return Eth2ConfigResponse{}, fmt.Errorf("Could not get eth2 config: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var eth2Config Eth2ConfigResponse
 	if err := json.Unmarshal(responseBody, &eth2Config); err != nil {
@@ -589,7 +599,7 @@ func (c *StandardHttpClient) getEth2DepositContract() (Eth2DepositContractRespon
 		return Eth2DepositContractResponse{}, fmt.Errorf("Could not get eth2 deposit contract: %w", err)
 	}
 	if status != http.StatusOK {
-		return Eth2DepositContractResponse{}, fmt.Errorf("Could not get eth2 deposit contract: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return Eth2DepositContractResponse{}, fmt.Errorf("Could not get eth2 deposit contract: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var eth2DepositContract Eth2DepositContractResponse
 	if err := json.Unmarshal(responseBody, &eth2DepositContract); err != nil {
@@ -605,7 +615,7 @@ func (c *StandardHttpClient) getGenesis() (GenesisResponse, error) {
 		return GenesisResponse{}, fmt.Errorf("Could not get genesis data: %w", err)
 	}
 	if status != http.StatusOK {
-		return GenesisResponse{}, fmt.Errorf("Could not get genesis data: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return GenesisResponse{}, fmt.Errorf("Could not get genesis data: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var genesis GenesisResponse
 	if err := json.Unmarshal(responseBody, &genesis); err != nil {
@@ -621,7 +631,7 @@ func (c *StandardHttpClient) getFinalityCheckpoints(stateId string) (FinalityChe
 		return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: %w", err)
 	}
 	if status != http.StatusOK {
-		return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return FinalityCheckpointsResponse{}, fmt.Errorf("Could not get finality checkpoints: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var finalityCheckpoints FinalityCheckpointsResponse
 	if err := json.Unmarshal(responseBody, &finalityCheckpoints); err != nil {
@@ -637,7 +647,7 @@ func (c *StandardHttpClient) getFork(stateId string) (ForkResponse, error) {
 		return ForkResponse{}, fmt.Errorf("Could not get fork data: %w", err)
 	}
 	if status != http.StatusOK {
-		return ForkResponse{}, fmt.Errorf("Could not get fork data: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return ForkResponse{}, fmt.Errorf("Could not get fork data: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var fork ForkResponse
 	if err := json.Unmarshal(responseBody, &fork); err != nil {
@@ -657,7 +667,7 @@ func (c *StandardHttpClient) getValidators(stateId string, pubkeys []string) (Va
 		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: %w", err)
 	}
 	if status != http.StatusOK {
-		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return ValidatorsResponse{}, fmt.Errorf("Could not get validators: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var validators ValidatorsResponse
 	if err := json.Unmarshal(responseBody, &validators); err != nil {
@@ -727,7 +737,7 @@ func (c *StandardHttpClient) postVoluntaryExit(request VoluntaryExitRequest) err
 		return fmt.Errorf("Could not broadcast exit for validator at index %d: %w", request.Message.ValidatorIndex, err)
 	}
 	if status != http.StatusOK {
-		return fmt.Errorf("Could not broadcast exit for validator at index %d: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
+		return fmt.Errorf("Could not broadcast exit for validator at index %d: %w", request.Message.ValidatorIndex, &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	return nil
 }
@@ -742,7 +752,7 @@ func (c *StandardHttpClient) getAttestations(blockId string) (AttestationsRespon
 		return AttestationsResponse{}, false, nil
 	}
 	if status != http.StatusOK {
-		return AttestationsResponse{}, false, fmt.Errorf("Could not get attestations data for slot %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+		return AttestationsResponse{}, false, fmt.Errorf("Could not get attestations data for slot %s: %w", blockId, &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var attestations AttestationsResponse
 	if err := json.Unmarshal(responseBody, &attestations); err != nil {
@@ -761,7 +771,7 @@ func (c *StandardHttpClient) getBeaconBlock(blockId string) (BeaconBlockResponse
 		return BeaconBlockResponse{}, false, nil
 	}
 	if status != http.StatusOK {
-		return BeaconBlockResponse{}, false, fmt.Errorf("Could not get beacon block data: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return BeaconBlockResponse{}, false, fmt.Errorf("Could not get beacon block data: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var beaconBlock BeaconBlockResponse
 	if err := json.Unmarshal(responseBody, &beaconBlock); err != nil {
@@ -781,7 +791,7 @@ func (c *StandardHttpClient) getCommittees(stateId string, epoch *uint64) (Commi
 		return CommitteesResponse{}, fmt.Errorf("Could not get committees: %w", err)
 	}
 	if status != http.StatusOK {
-		return CommitteesResponse{}, fmt.Errorf("Could not get committees: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return CommitteesResponse{}, fmt.Errorf("Could not get committees: %w", &HttpStatusError{StatusCode: status, ResponseBody: string(responseBody)})
 	}
 	var committees CommitteesResponse
 	if err := json.Unmarshal(responseBody, &committees); err != nil {
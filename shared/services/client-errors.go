@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+)
+
+// Returns true if a client request failed because the client couldn't be reached or didn't respond in time. The client
+// should be considered disconnected and its requests should go to the fallback until it recovers.
+func isConnectionError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	message := err.Error()
+	return strings.Contains(message, "dial tcp") || strings.Contains(message, "connection refused")
+}
+
+// Returns true if a client request failed with a 5xx response. The client is still connected, so only that request should
+// be retried on the fallback.
+func isServerError(err error) bool {
+	statusCode := getHttpStatusCode(err)
+	return statusCode >= 500 && statusCode < 600
+}
+
+// Returns true if a client request failed in a way that's worth retrying on a different client, such as a connection
+// failure, a timeout, or a 5xx response. Client errors (4xx) and canceled requests aren't retryable, since they would fail
+// the same way anywhere else.
+func isRetryableError(err error) bool {
+	return isConnectionError(err) || isServerError(err)
+}
+
+// Get the HTTP status code of a failed client request, or 0 if the error doesn't have one
+func getHttpStatusCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	// Execution client errors
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode
+	}
+
+	// Beacon client errors
+	var statusErr *client.HttpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	return 0
+}
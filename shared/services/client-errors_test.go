@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"no error", nil, false},
+		{"canceled", context.Canceled, false},
+		{"wrapped cancel", fmt.Errorf("error getting block: %w", context.Canceled), false},
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"wrapped deadline exceeded", fmt.Errorf("error getting block: %w", context.DeadlineExceeded), true},
+		{"network timeout", &net.DNSError{Err: "i/o timeout", Name: "eth1", IsTimeout: true}, true},
+		{"network error without a timeout", &net.DNSError{Err: "no such host", Name: "eth1"}, false},
+		{"connection refused", errors.New("dial tcp 127.0.0.1:8545: connect: connection refused"), true},
+		{"execution client 503", fmt.Errorf("error getting block: %w", rpc.HTTPError{StatusCode: 503, Status: "503 Service Unavailable"}), true},
+		{"execution client 429", rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}, false},
+		{"beacon node 500", fmt.Errorf("Could not get node sync status: %w", &client.HttpStatusError{StatusCode: 500}), true},
+		{"beacon node 502", &client.HttpStatusError{StatusCode: 502}, true},
+		{"beacon node 400", &client.HttpStatusError{StatusCode: 400}, false},
+		{"beacon node 404", fmt.Errorf("Could not get validator status: %w", &client.HttpStatusError{StatusCode: 404}), false},
+		{"reverted call", errors.New("execution reverted"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			retryable := isRetryableError(test.err)
+			if retryable != test.retryable {
+				t.Errorf("expected isRetryableError(%v) to be %t, got %t", test.err, test.retryable, retryable)
+			}
+		})
+	}
+}
+
+func TestIsConnectionError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		disconnected bool
+	}{
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"connection refused", errors.New("dial tcp 127.0.0.1:5052: connect: connection refused"), true},
+		{"server error", &client.HttpStatusError{StatusCode: 502}, false},
+		{"canceled", context.Canceled, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			disconnected := isConnectionError(test.err)
+			if disconnected != test.disconnected {
+				t.Errorf("expected isConnectionError(%v) to be %t, got %t", test.err, test.disconnected, disconnected)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/alessio/shellescape"
+)
+
+// Constants
+const (
+	backupContainerPath string = "/.rocketpool/backups"
+	minBackupInterval          = time.Hour
+)
+
+// Get the interval between automatic backups of the validator keys and slashing protection database.
+// Returns 0 if backups are disabled.
+func (cfg *SmartnodeConfig) GetBackupInterval() (time.Duration, error) {
+	schedule := cfg.BackupSchedule.Value.(string)
+	if schedule == "" {
+		return 0, nil
+	}
+
+	interval, err := time.ParseDuration(schedule)
+	if err != nil {
+		return 0, fmt.Errorf("backup schedule [%s] is not a valid duration: %w", schedule, err)
+	}
+	if interval < minBackupInterval {
+		return 0, fmt.Errorf("backup schedule [%s] is too short; backups can run at most once every %s", schedule, minBackupInterval)
+	}
+	return interval, nil
+}
+
+// Get the time of the next automatic backup after the provided time.
+// Backups are aligned to multiples of the schedule's interval, so every node with the same schedule backs up at the same times.
+func (cfg *SmartnodeConfig) NextBackupTime(from time.Time) (time.Time, error) {
+	interval, err := cfg.GetBackupInterval()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if interval == 0 {
+		return time.Time{}, fmt.Errorf("automatic backups are disabled")
+	}
+
+	return from.Truncate(interval).Add(interval), nil
+}
+
+// Get the folder that automatic backups are stored in
+func (cfg *SmartnodeConfig) GetBackupFolder(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return backupContainerPath
+	}
+
+	return getAbsolutePath(os.ExpandEnv(cfg.BackupPath.Value.(string)))
+}
+
+// Checks the backup settings; if they're invalid, returns a list of errors
+func (cfg *SmartnodeConfig) validateBackupSettings() []string {
+	errors := []string{}
+
+	interval, err := cfg.GetBackupInterval()
+	if err != nil {
+		errors = append(errors, fmt.Sprintf("[%s - %s] is not valid: %s", cfg.Title, cfg.BackupSchedule.Name, err.Error()))
+	}
+	if interval == 0 {
+		return errors
+	}
+
+	backupPath := cfg.BackupPath.Value.(string)
+	if backupPath == "" {
+		errors = append(errors, fmt.Sprintf("[%s - %s] cannot be blank when automatic backups are enabled.", cfg.Title, cfg.BackupPath.Name))
+	} else if err := checkDirectoryWritable(cfg.GetBackupFolder(false)); err != nil {
+		errors = append(errors, fmt.Sprintf("[%s - %s] is not valid: %s", cfg.Title, cfg.BackupPath.Name, err.Error()))
+	}
+
+	return errors
+}
+
+// Checks that a directory exists and that files can be created in it, without creating anything
+func checkDirectoryWritable(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", shellescape.Quote(path), err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", shellescape.Quote(path))
+	}
+
+	if err := checkWriteAccess(path); err != nil {
+		return fmt.Errorf("%s is not writable: %w", shellescape.Quote(path), err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetBackupInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		expected time.Duration
+		valid    bool
+	}{
+		{"disabled", "", 0, true},
+		{"daily", "24h", 24 * time.Hour, true},
+		{"minimum", "1h", time.Hour, true},
+		{"mixed units", "1h30m", 90 * time.Minute, true},
+		{"too short", "59m", 0, false},
+		{"not a duration", "daily", 0, false},
+		{"cron syntax", "0 3 * * *", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Smartnode.BackupSchedule.Value = test.schedule
+			interval, err := cfg.Smartnode.GetBackupInterval()
+			if !test.valid {
+				if err == nil {
+					t.Errorf("expected schedule [%s] to be rejected, got %s", test.schedule, interval)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected schedule [%s] to be valid, got error: %s", test.schedule, err.Error())
+			}
+			if interval != test.expected {
+				t.Errorf("expected an interval of %s, got %s", test.expected, interval)
+			}
+		})
+	}
+}
+
+func TestNextBackupTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		from     time.Time
+		expected time.Time
+	}{
+		{"hourly", "1h", time.Date(2022, 6, 1, 10, 20, 0, 0, time.UTC), time.Date(2022, 6, 1, 11, 0, 0, 0, time.UTC)},
+		{"every 6 hours", "6h", time.Date(2022, 6, 1, 10, 20, 0, 0, time.UTC), time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)},
+		{"on a boundary", "6h", time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC), time.Date(2022, 6, 1, 18, 0, 0, 0, time.UTC)},
+		{"daily", "24h", time.Date(2022, 6, 1, 23, 59, 0, 0, time.UTC), time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Smartnode.BackupSchedule.Value = test.schedule
+			next, err := cfg.Smartnode.NextBackupTime(test.from)
+			if err != nil {
+				t.Fatalf("error getting the next backup time: %s", err.Error())
+			}
+			if !next.Equal(test.expected) {
+				t.Errorf("expected the next backup at %s, got %s", test.expected, next)
+			}
+		})
+	}
+
+	cfg := newTestConfig()
+	cfg.Smartnode.BackupSchedule.Value = ""
+	if _, err := cfg.Smartnode.NextBackupTime(time.Now()); err == nil {
+		t.Error("expected an error getting the next backup time with backups disabled")
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+// +build !windows
+
+package config
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// Checks if the current user can create files in a directory
+func checkWriteAccess(path string) error {
+	return unix.Access(path, unix.W_OK)
+}
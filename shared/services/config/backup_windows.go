@@ -0,0 +1,22 @@
+//go:build windows
+// +build windows
+
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// Checks if the current user can create files in a directory.
+// Windows doesn't have an access check in the standard library, so this only checks the directory's read-only flag.
+func checkWriteAccess(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Mode().Perm()&0200 == 0 {
+		return fmt.Errorf("the directory is read-only")
+	}
+	return nil
+}
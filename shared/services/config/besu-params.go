@@ -8,6 +8,8 @@ import (
 const (
 	besuTagTest          string = "hyperledger/besu:22.10.2-openjdk-latest"
 	besuTagProd          string = "hyperledger/besu:22.10.2-openjdk-latest"
+	besuTagBeta          string = "hyperledger/besu:latest"
+	besuTagNightly       string = "hyperledger/besu:develop"
 	besuEventLogInterval int    = 25000
 	besuMaxPeers         uint16 = 25
 	besuStopSignal       string = "SIGTERM"
@@ -35,6 +37,9 @@ type BesuConfig struct {
 	// Historical state block regeneration limit
 	MaxBackLayers config.Parameter `yaml:"maxBackLayers,omitempty"`
 
+	// The log level for Besu
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
 	// The Docker Hub tag for Besu
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -54,6 +59,7 @@ func NewBesuConfig(cfg *RocketPoolConfig) *BesuConfig {
 			config.ConsensusClient_Nimbus,
 			config.ConsensusClient_Prysm,
 			config.ConsensusClient_Teku,
+			config.ConsensusClient_Lodestar,
 		},
 
 		EventLogInterval: besuEventLogInterval,
@@ -63,7 +69,7 @@ func NewBesuConfig(cfg *RocketPoolConfig) *BesuConfig {
 			Name:                 "JVM Heap Size",
 			Description:          "The max amount of RAM, in MB, that Besu's JVM should limit itself to. Setting this lower will cause Besu to use less RAM, though it will always use more than this limit.\n\nUse 0 for automatic allocation.",
 			Type:                 config.ParameterType_Uint,
-			Default:              map[config.Network]interface{}{config.Network_All: uint64(0)},
+			Default:              map[config.Network]interface{}{config.Network_All: calculateBesuHeapSize(getTotalMemoryGB())},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"BESU_JVM_HEAP_SIZE"},
 			CanBeBlank:           false,
@@ -94,6 +100,8 @@ func NewBesuConfig(cfg *RocketPoolConfig) *BesuConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		LogLevel: generateLogLevelParameter("Besu", []config.ContainerID{config.ContainerID_Eth1}),
+
 		ContainerTag: config.Parameter{
 			ID:          "containerTag",
 			Name:        "Container Tag",
@@ -124,12 +132,28 @@ func NewBesuConfig(cfg *RocketPoolConfig) *BesuConfig {
 	}
 }
 
+// Calculate the default JVM heap size for Besu based on the system's total RAM
+func calculateBesuHeapSize(totalMemoryGB uint64) uint64 {
+	if totalMemoryGB == 0 {
+		return 0
+	} else if totalMemoryGB < 9 {
+		return 2048
+	} else if totalMemoryGB < 17 {
+		return 4096
+	} else if totalMemoryGB < 33 {
+		return 8192
+	} else {
+		return 0
+	}
+}
+
 // Get the parameters for this config
 func (cfg *BesuConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.JvmHeapSize,
 		&cfg.MaxPeers,
 		&cfg.MaxBackLayers,
+		&cfg.LogLevel,
 		&cfg.ContainerTag,
 		&cfg.AdditionalFlags,
 	}
@@ -39,6 +39,7 @@ func NewBitflyNodeMetricsConfig(cfg *RocketPoolConfig) *BitflyNodeMetricsConfig
 			Regex:              "^[A-Za-z0-9+/]{28}$",
 			CanBeBlank:         false,
 			OverwriteOnUpgrade: false,
+			Secret:             true,
 		},
 
 		Endpoint: config.Parameter{
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Constants
+const (
+	bootnodesID string = "bootnodes"
+)
+
+var enodeRegex = regexp.MustCompile("^enode://[0-9a-fA-F]{128}@[^:/?]+:[0-9]{1,5}(\\?discport=[0-9]{1,5})?$")
+var enrRegex = regexp.MustCompile("^enr:-[A-Za-z0-9_-]+$")
+
+// Generates a parameter for overriding a client's built-in list of bootnodes
+func generateBootnodesParameter(clientType string, formats string, container config.ContainerID, envVar string) config.Parameter {
+	return config.Parameter{
+		ID:                   bootnodesID,
+		Name:                 "Bootnodes",
+		Description:          fmt.Sprintf("A comma-separated list of bootnodes (in %s format) that your %s client should use to find peers, instead of its built-in ones. This is usually only needed on private networks or new testnets.\n\nLeave this blank to use the client's built-in bootnodes.", formats, clientType),
+		Type:                 config.ParameterType_String,
+		Default:              map[config.Network]interface{}{config.Network_All: ""},
+		AffectsContainers:    []config.ContainerID{container},
+		EnvironmentVariables: []string{envVar},
+		CanBeBlank:           true,
+		OverwriteOnUpgrade:   false,
+	}
+}
+
+// Splits a comma-separated bootnode list into its individual entries
+func getBootnodes(param *config.Parameter) []string {
+	bootnodes := []string{}
+	for _, bootnode := range strings.Split(param.Value.(string), ",") {
+		bootnode = strings.TrimSpace(bootnode)
+		if bootnode != "" {
+			bootnodes = append(bootnodes, bootnode)
+		}
+	}
+	return bootnodes
+}
+
+// Checks each entry in a bootnode list against the allowed formats; if any are invalid, returns a list of errors
+func validateBootnodes(title string, param *config.Parameter, allowEnode bool) []string {
+	errors := []string{}
+	for _, bootnode := range getBootnodes(param) {
+		if enrRegex.MatchString(bootnode) || (allowEnode && enodeRegex.MatchString(bootnode)) {
+			continue
+		}
+		if allowEnode {
+			errors = append(errors, fmt.Sprintf("[%s - %s] contains an invalid entry (%s). Each bootnode must be an enode URL or an ENR.", title, param.Name, bootnode))
+		} else {
+			errors = append(errors, fmt.Sprintf("[%s - %s] contains an invalid entry (%s). Each bootnode must be an ENR.", title, param.Name, bootnode))
+		}
+	}
+
+	return errors
+}
+
+// Get the command line flag(s) that set an Execution client's bootnodes
+func GetExecutionClientBootnodesFlag(client config.ExecutionClient, bootnodes []string) string {
+	if len(bootnodes) == 0 {
+		return ""
+	}
+
+	list := strings.Join(bootnodes, ",")
+	switch client {
+	case config.ExecutionClient_Geth, config.ExecutionClient_Besu, config.ExecutionClient_Erigon:
+		return fmt.Sprintf("--bootnodes=%s", list)
+	case config.ExecutionClient_Nethermind:
+		return fmt.Sprintf("--Discovery.Bootnodes=%s", list)
+	default:
+		return ""
+	}
+}
+
+// Get the command line flag(s) that set a Consensus client's bootnodes
+func GetConsensusClientBootnodesFlag(client config.ConsensusClient, bootnodes []string) string {
+	if len(bootnodes) == 0 {
+		return ""
+	}
+
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		return fmt.Sprintf("--boot-nodes=%s", strings.Join(bootnodes, ","))
+	case config.ConsensusClient_Teku:
+		return fmt.Sprintf("--p2p-discovery-bootnodes=%s", strings.Join(bootnodes, ","))
+	case config.ConsensusClient_Lodestar:
+		return fmt.Sprintf("--bootnodes=%s", strings.Join(bootnodes, ","))
+	case config.ConsensusClient_Nimbus, config.ConsensusClient_Prysm:
+		// These take one flag per bootnode
+		flags := make([]string, len(bootnodes))
+		for i, bootnode := range bootnodes {
+			flags[i] = fmt.Sprintf("--bootstrap-node=%s", bootnode)
+		}
+		return strings.Join(flags, " ")
+	default:
+		return ""
+	}
+}
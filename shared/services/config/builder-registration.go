@@ -0,0 +1,163 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Constants
+const (
+	BuilderRegistrationOverridesID string = "builderRegistrationOverrides"
+	builderRegistrationOverrideSep string = ","
+	builderRegistrationFieldSep    string = ":"
+)
+
+var validatorPubkeyRegex = regexp.MustCompile("^0x[0-9a-f]{96}$")
+
+// Generates the parameter for overriding the builder API registration settings of individual validators
+func generateBuilderRegistrationOverridesParameter() config.Parameter {
+	return config.Parameter{
+		ID:                   BuilderRegistrationOverridesID,
+		Name:                 "Builder Registration Overrides",
+		Description:          "If you use MEV-Boost, you can override the gas limit and builder API registration of individual validators here. Enter a comma-separated list of `pubkey:gasLimit:enabled` entries, such as `0x1234...:30000000:true`. Set enabled to `false` to keep a validator from registering with the relays.\n\nThis is currently only used by Teku; other clients ignore it.\n\nLeave this blank to use the default settings for every validator.",
+		Type:                 config.ParameterType_String,
+		Default:              map[config.Network]interface{}{config.Network_All: ""},
+		AffectsContainers:    []config.ContainerID{config.ContainerID_Validator},
+		EnvironmentVariables: []string{},
+		CanBeBlank:           true,
+		OverwriteOnUpgrade:   false,
+	}
+}
+
+// Get the builder API registration overrides for individual validators
+func (cfg *ConsensusCommonConfig) GetBuilderRegistrationOverrides() ([]config.BuilderRegistrationOverride, error) {
+	overrides := []config.BuilderRegistrationOverride{}
+	value := strings.TrimSpace(cfg.BuilderRegistrationOverrides.Value.(string))
+	if value == "" {
+		return overrides, nil
+	}
+
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(value, builderRegistrationOverrideSep) {
+		entry = strings.TrimSpace(entry)
+		fields := strings.Split(entry, builderRegistrationFieldSep)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("[%s] is not a pubkey:gasLimit:enabled entry", entry)
+		}
+
+		pubkey := strings.ToLower(fields[0])
+		if !validatorPubkeyRegex.MatchString(pubkey) {
+			return nil, fmt.Errorf("[%s] is not a valid validator pubkey", fields[0])
+		}
+		if seen[pubkey] {
+			return nil, fmt.Errorf("validator %s has more than one override", pubkey)
+		}
+		seen[pubkey] = true
+
+		gasLimit, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not a valid gas limit for validator %s", fields[1], pubkey)
+		}
+		if gasLimit < minSuggestedBlockGasLimit || gasLimit > maxSuggestedBlockGasLimit {
+			return nil, fmt.Errorf("the gas limit for validator %s must be between %d and %d", pubkey, minSuggestedBlockGasLimit, maxSuggestedBlockGasLimit)
+		}
+
+		enabled, err := strconv.ParseBool(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not true or false for validator %s", fields[2], pubkey)
+		}
+
+		overrides = append(overrides, config.BuilderRegistrationOverride{
+			Pubkey:   pubkey,
+			GasLimit: gasLimit,
+			Enabled:  enabled,
+		})
+	}
+	return overrides, nil
+}
+
+// Checks the builder API registration overrides; if they're invalid, returns a list of errors
+func (cfg *ConsensusCommonConfig) validateBuilderRegistrationOverrides() []string {
+	errors := []string{}
+	_, err := cfg.GetBuilderRegistrationOverrides()
+	if err != nil {
+		errors = append(errors, fmt.Sprintf("[%s - %s] is not valid: %s", cfg.Title, cfg.BuilderRegistrationOverrides.Name, err.Error()))
+	}
+	return errors
+}
+
+// The builder section of a Teku proposer config entry
+type tekuBuilderConfig struct {
+	Enabled  bool   `json:"enabled"`
+	GasLimit string `json:"gas_limit"`
+}
+
+// A Teku proposer config entry
+type tekuProposerConfigEntry struct {
+	FeeRecipient string            `json:"fee_recipient"`
+	Builder      tekuBuilderConfig `json:"builder"`
+}
+
+// Teku's proposer config file, passed with `--validators-proposer-config`
+type tekuProposerConfig struct {
+	ProposerConfig map[string]tekuProposerConfigEntry `json:"proposer_config"`
+	DefaultConfig  tekuProposerConfigEntry            `json:"default_config"`
+}
+
+// Returns true if the Smartnode's Validator client is Teku and it needs a proposer config file for the builder API
+// registration overrides
+func (cfg *RocketPoolConfig) UsesTekuProposerConfig() bool {
+	if cfg.IsNativeMode ||
+		cfg.ConsensusClientMode.Value.(config.Mode) != config.Mode_Local ||
+		cfg.ConsensusClient.Value.(config.ConsensusClient) != config.ConsensusClient_Teku {
+		return false
+	}
+	return strings.TrimSpace(cfg.ConsensusCommon.BuilderRegistrationOverrides.Value.(string)) != ""
+}
+
+// Get the command line flag that points Teku's Validator client to the proposer config file
+func getTekuProposerConfigFlag() string {
+	return fmt.Sprintf("--validators-proposer-config=%s", path.Join(validatorsContainerPath, TekuProposerConfigFilename))
+}
+
+// Generates a Teku proposer config file that applies the builder API registration overrides on top of the default settings.
+// Every validator uses the provided fee recipient, since Rocket Pool validators must use the node's fee recipient.
+func (cfg *ConsensusCommonConfig) GenerateTekuProposerConfig(feeRecipient common.Address, builderEnabled bool) ([]byte, error) {
+	overrides, err := cfg.GetBuilderRegistrationOverrides()
+	if err != nil {
+		return nil, err
+	}
+
+	proposerConfig := tekuProposerConfig{
+		ProposerConfig: map[string]tekuProposerConfigEntry{},
+		DefaultConfig: tekuProposerConfigEntry{
+			FeeRecipient: feeRecipient.Hex(),
+			Builder: tekuBuilderConfig{
+				Enabled:  builderEnabled,
+				GasLimit: strconv.FormatUint(cfg.SuggestedBlockGasLimit.Value.(uint64), 10),
+			},
+		},
+	}
+	for _, override := range overrides {
+		proposerConfig.ProposerConfig[override.Pubkey] = tekuProposerConfigEntry{
+			FeeRecipient: feeRecipient.Hex(),
+			Builder: tekuBuilderConfig{
+				Enabled:  builderEnabled && override.Enabled,
+				GasLimit: strconv.FormatUint(override.GasLimit, 10),
+			},
+		}
+	}
+
+	bytes, err := json.MarshalIndent(proposerConfig, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error serializing Teku proposer config: %w", err)
+	}
+	return bytes, nil
+}
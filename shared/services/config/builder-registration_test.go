@@ -0,0 +1,119 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestGetBuilderRegistrationOverrides(t *testing.T) {
+	pubkey1 := "0x" + strings.Repeat("a", 96)
+	pubkey2 := "0x" + strings.Repeat("b", 96)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected int
+		valid    bool
+	}{
+		{"blank", "", 0, true},
+		{"single override", pubkey1 + ":30000000:true", 1, true},
+		{"multiple overrides", pubkey1 + ":30000000:true, " + pubkey2 + ":25000000:false", 2, true},
+		{"uppercase pubkey", "0x" + strings.ToUpper(pubkey1[2:]) + ":30000000:true", 1, true},
+		{"pubkey without prefix", pubkey1[2:] + ":30000000:true", 0, false},
+		{"missing field", pubkey1 + ":30000000", 0, false},
+		{"bad pubkey", "0x1234:30000000:true", 0, false},
+		{"duplicate pubkey", pubkey1 + ":30000000:true," + pubkey1 + ":25000000:false", 0, false},
+		{"non-numeric gas limit", pubkey1 + ":lots:true", 0, false},
+		{"gas limit at minimum", pubkey1 + ":5000000:true", 1, true},
+		{"gas limit below minimum", pubkey1 + ":4999999:true", 0, false},
+		{"gas limit at maximum", pubkey1 + ":100000000:true", 1, true},
+		{"gas limit above maximum", pubkey1 + ":100000001:true", 0, false},
+		{"bad enabled flag", pubkey1 + ":30000000:yes", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ConsensusCommon.BuilderRegistrationOverrides.Value = test.value
+			overrides, err := cfg.ConsensusCommon.GetBuilderRegistrationOverrides()
+			if !test.valid {
+				if err == nil {
+					t.Errorf("expected [%s] to be rejected", test.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected [%s] to be valid, got error: %s", test.value, err.Error())
+			}
+			if len(overrides) != test.expected {
+				t.Errorf("expected %d overrides, got %d", test.expected, len(overrides))
+			}
+		})
+	}
+}
+
+func TestGenerateTekuProposerConfig(t *testing.T) {
+	pubkey1 := "0x" + strings.Repeat("a", 96)
+	pubkey2 := "0x" + strings.Repeat("b", 96)
+	feeRecipient := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+
+	tests := []struct {
+		name           string
+		builderEnabled bool
+		expected       map[string]bool
+	}{
+		{"builder enabled", true, map[string]bool{pubkey1: true, pubkey2: false}},
+		{"builder disabled", false, map[string]bool{pubkey1: false, pubkey2: false}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ConsensusCommon.SuggestedBlockGasLimit.Value = uint64(30000000)
+			cfg.ConsensusCommon.BuilderRegistrationOverrides.Value = pubkey1 + ":25000000:true," + pubkey2 + ":35000000:false"
+
+			bytes, err := cfg.ConsensusCommon.GenerateTekuProposerConfig(feeRecipient, test.builderEnabled)
+			if err != nil {
+				t.Fatalf("error generating Teku proposer config: %s", err.Error())
+			}
+			var proposerConfig tekuProposerConfig
+			if err := json.Unmarshal(bytes, &proposerConfig); err != nil {
+				t.Fatalf("error parsing Teku proposer config: %s", err.Error())
+			}
+
+			if proposerConfig.DefaultConfig.FeeRecipient != feeRecipient.Hex() {
+				t.Errorf("expected a default fee recipient of %s, got %s", feeRecipient.Hex(), proposerConfig.DefaultConfig.FeeRecipient)
+			}
+			if proposerConfig.DefaultConfig.Builder.Enabled != test.builderEnabled {
+				t.Errorf("expected the default builder setting to be %t, got %t", test.builderEnabled, proposerConfig.DefaultConfig.Builder.Enabled)
+			}
+			if proposerConfig.DefaultConfig.Builder.GasLimit != "30000000" {
+				t.Errorf("expected a default gas limit of 30000000, got %s", proposerConfig.DefaultConfig.Builder.GasLimit)
+			}
+
+			gasLimits := map[string]string{pubkey1: "25000000", pubkey2: "35000000"}
+			if len(proposerConfig.ProposerConfig) != len(test.expected) {
+				t.Fatalf("expected %d proposer config entries, got %d", len(test.expected), len(proposerConfig.ProposerConfig))
+			}
+			for pubkey, enabled := range test.expected {
+				entry, exists := proposerConfig.ProposerConfig[pubkey]
+				if !exists {
+					t.Errorf("expected a proposer config entry for %s", pubkey)
+					continue
+				}
+				if entry.FeeRecipient != feeRecipient.Hex() {
+					t.Errorf("expected %s to use fee recipient %s, got %s", pubkey, feeRecipient.Hex(), entry.FeeRecipient)
+				}
+				if entry.Builder.Enabled != enabled {
+					t.Errorf("expected the builder setting for %s to be %t, got %t", pubkey, enabled, entry.Builder.Enabled)
+				}
+				if entry.Builder.GasLimit != gasLimits[pubkey] {
+					t.Errorf("expected a gas limit of %s for %s, got %s", gasLimits[pubkey], pubkey, entry.Builder.GasLimit)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/alessio/shellescape"
+)
+
+// Loads a bundle of PEM-encoded certificate authorities into a certificate pool that includes the system's default ones
+func LoadCaBundle(path string) (*x509.CertPool, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA bundle at %s: %w", shellescape.Quote(path), err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(bytes) {
+		return nil, fmt.Errorf("%s does not contain any PEM-encoded certificates", shellescape.Quote(path))
+	}
+	return pool, nil
+}
+
+// Get the path of the custom CA bundle, or an empty string if there isn't one
+func (cfg *SmartnodeConfig) GetCaBundlePath(daemon bool) string {
+	caBundlePath := cfg.CaBundlePath.Value.(string)
+	if caBundlePath == "" {
+		return ""
+	}
+	if daemon && !cfg.parent.IsNativeMode {
+		return caBundleContainerPath
+	}
+
+	return getAbsolutePath(os.ExpandEnv(caBundlePath))
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadCaBundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "rocketpool-ca")
+	if err != nil {
+		t.Fatalf("error creating temporary directory: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	// Write the test server's certificate as the CA bundle
+	caBundlePath := filepath.Join(dir, "ca.pem")
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	err = ioutil.WriteFile(caBundlePath, caBundle, 0644)
+	if err != nil {
+		t.Fatalf("error writing CA bundle: %s", err.Error())
+	}
+
+	// A transport without the bundle shouldn't trust the server
+	client := &http.Client{Transport: &http.Transport{}}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Fatal("expected the test server's certificate not to be trusted by default")
+	}
+
+	// A transport with the bundle should
+	pool, err := LoadCaBundle(caBundlePath)
+	if err != nil {
+		t.Fatalf("error loading CA bundle: %s", err.Error())
+	}
+	client = &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+	response, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the test server's certificate to be trusted, got error: %s", err.Error())
+	}
+	response.Body.Close()
+}
+
+func TestCaBundleValidation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rocketpool-ca")
+	if err != nil {
+		t.Fatalf("error creating temporary directory: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	notPemPath := filepath.Join(dir, "not-a-ca.pem")
+	err = ioutil.WriteFile(notPemPath, []byte("this is not a certificate"), 0644)
+	if err != nil {
+		t.Fatalf("error writing file: %s", err.Error())
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		valid bool
+	}{
+		{"no bundle", "", true},
+		{"missing file", filepath.Join(dir, "missing.pem"), false},
+		{"not a PEM file", notPemPath, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Smartnode.CaBundlePath.Value = test.path
+
+			hasError := false
+			for _, err := range cfg.Validate() {
+				if strings.Contains(err, cfg.Smartnode.CaBundlePath.Name) {
+					hasError = true
+				}
+			}
+			if test.valid && hasError {
+				t.Errorf("expected [%s] to be valid", test.path)
+			}
+			if !test.valid && !hasError {
+				t.Errorf("expected [%s] to be rejected", test.path)
+			}
+		})
+	}
+}
+
+func TestGetCaBundlePath(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Smartnode.CaBundlePath.Value = "/etc/ssl/corporate-ca.pem"
+	if path := cfg.Smartnode.GetCaBundlePath(false); path != "/etc/ssl/corporate-ca.pem" {
+		t.Errorf("expected the host path, got %s", path)
+	}
+	if path := cfg.Smartnode.GetCaBundlePath(true); path != caBundleContainerPath {
+		t.Errorf("expected the daemons to use the container path %s, got %s", caBundleContainerPath, path)
+	}
+
+	cfg.IsNativeMode = true
+	if path := cfg.Smartnode.GetCaBundlePath(true); path != "/etc/ssl/corporate-ca.pem" {
+		t.Errorf("expected the daemons to use the host path in Native mode, got %s", path)
+	}
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// A setting that only works on a client version at or above a minimum
+type clientVersionRequirement struct {
+	// The name of the client, for display
+	clientName string
+
+	// Get the container tag of the client if it's in use, or an empty string if it isn't
+	getContainerTag func(cfg *RocketPoolConfig) string
+
+	// Get the parameter that requires the minimum version
+	getParameter func(cfg *RocketPoolConfig) *config.Parameter
+
+	// The first version of the client that supports the parameter when it's changed from its default
+	minVersion string
+}
+
+// Get the settings that older client versions don't support
+func getClientVersionRequirements() []clientVersionRequirement {
+	return []clientVersionRequirement{
+		{
+			clientName:      "Geth",
+			getContainerTag: getLocalEcContainerTag(config.ExecutionClient_Geth),
+			getParameter:    func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.Geth.EnableExpensiveMetrics },
+			minVersion:      "1.9.0",
+		},
+		{
+			clientName:      "Lighthouse",
+			getContainerTag: getLocalCcContainerTag(config.ConsensusClient_Lighthouse),
+			getParameter:    func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.SuggestedBlockGasLimit },
+			minVersion:      "2.4.0",
+		},
+		{
+			clientName:      "Nimbus",
+			getContainerTag: getLocalCcContainerTag(config.ConsensusClient_Nimbus),
+			getParameter:    func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.SuggestedBlockGasLimit },
+			minVersion:      "22.7.0",
+		},
+		{
+			clientName:      "Nimbus",
+			getContainerTag: getLocalCcContainerTag(config.ConsensusClient_Nimbus),
+			getParameter:    func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.CheckpointVerifyRoot },
+			minVersion:      "22.12.0",
+		},
+		{
+			clientName:      "Prysm",
+			getContainerTag: getLocalCcContainerTag(config.ConsensusClient_Prysm),
+			getParameter:    func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.SuggestedBlockGasLimit },
+			minVersion:      "3.1.0",
+		},
+		{
+			clientName:      "Teku",
+			getContainerTag: getLocalCcContainerTag(config.ConsensusClient_Teku),
+			getParameter:    func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.SuggestedBlockGasLimit },
+			minVersion:      "22.9.1",
+		},
+	}
+}
+
+// Checks the settings that have been changed from their defaults against the versions of the clients they'll be used with,
+// based on the clients' container tags; if any aren't supported by those versions, returns a list of issues describing them.
+// Container tags that don't include a version (such as `latest`) can't be checked and are skipped.
+func (cfg *RocketPoolConfig) ValidateAgainstClientVersions() []config.Issue {
+	issues := []config.Issue{}
+	if cfg.IsNativeMode {
+		return issues
+	}
+	network := cfg.Smartnode.Network.Value.(config.Network)
+
+	for _, requirement := range getClientVersionRequirements() {
+		containerTag := requirement.getContainerTag(cfg)
+		if containerTag == "" {
+			continue
+		}
+		clientVersion := getContainerTagVersion(containerTag)
+		if clientVersion == nil {
+			continue
+		}
+
+		param := requirement.getParameter(cfg)
+		defaultValue, err := param.GetDefault(network)
+		if err != nil || param.Value == defaultValue {
+			continue
+		}
+
+		minVersion, err := version.NewVersion(requirement.minVersion)
+		if err != nil {
+			continue
+		}
+		if clientVersion.LessThan(minVersion) {
+			issues = append(issues, config.Issue{
+				Severity: config.IssueSeverity_Error,
+				Message:  fmt.Sprintf("[%s] requires %s v%s or newer, but your container tag (%s) is for v%s. Please update the container tag or revert this setting to its default.", param.Name, requirement.clientName, requirement.minVersion, containerTag, clientVersion.String()),
+			})
+		}
+	}
+
+	return issues
+}
+
+// Creates a function that gets the container tag for an Execution client, if it's the locally managed one
+func getLocalEcContainerTag(client config.ExecutionClient) func(cfg *RocketPoolConfig) string {
+	return func(cfg *RocketPoolConfig) string {
+		if cfg.ExecutionClientMode.Value.(config.Mode) != config.Mode_Local || cfg.ExecutionClient.Value.(config.ExecutionClient) != client {
+			return ""
+		}
+		switch client {
+		case config.ExecutionClient_Geth:
+			return cfg.Geth.ContainerTag.Value.(string)
+		case config.ExecutionClient_Nethermind:
+			return cfg.Nethermind.ContainerTag.Value.(string)
+		case config.ExecutionClient_Besu:
+			return cfg.Besu.ContainerTag.Value.(string)
+		case config.ExecutionClient_Erigon:
+			return cfg.Erigon.ContainerTag.Value.(string)
+		default:
+			return ""
+		}
+	}
+}
+
+// Creates a function that gets the Validator Client container tag for a Consensus client, if it's the locally managed one
+func getLocalCcContainerTag(client config.ConsensusClient) func(cfg *RocketPoolConfig) string {
+	return func(cfg *RocketPoolConfig) string {
+		if cfg.ConsensusClientMode.Value.(config.Mode) != config.Mode_Local || cfg.ConsensusClient.Value.(config.ConsensusClient) != client {
+			return ""
+		}
+		ccConfig, err := cfg.GetSelectedConsensusClientConfig()
+		if err != nil {
+			return ""
+		}
+		return ccConfig.GetValidatorImage()
+	}
+}
+
+// Get the client version from a container tag such as `sigp/lighthouse:v3.3.0-modern`, or nil if the tag doesn't have one
+func getContainerTagVersion(containerTag string) *version.Version {
+	separator := strings.LastIndex(containerTag, ":")
+	if separator == -1 {
+		return nil
+	}
+	tag := strings.TrimPrefix(containerTag[separator+1:], "v")
+
+	// Drop build variants like `-modern` or `-openjdk-latest`, which would otherwise be treated as pre-releases
+	tag = strings.SplitN(tag, "-", 2)[0]
+	clientVersion, err := version.NewVersion(tag)
+	if err != nil {
+		return nil
+	}
+	return clientVersion
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestValidateAgainstClientVersions(t *testing.T) {
+	tests := []struct {
+		name             string
+		gethTag          string
+		expensiveMetrics bool
+		ecMode           config.Mode
+		lighthouseTag    string
+		gasLimit         uint64
+		expectedClients  []string
+	}{
+		{"a flag unsupported by the pinned Geth version is flagged", "ethereum/client-go:v1.8.27", true, config.Mode_Local, "sigp/lighthouse:v3.3.0", defaultSuggestedBlockGasLimit, []string{"Geth v1.9.0"}},
+		{"a flag supported by the pinned Geth version", "ethereum/client-go:v1.10.26", true, config.Mode_Local, "sigp/lighthouse:v3.3.0", defaultSuggestedBlockGasLimit, []string{}},
+		{"an old Geth version with the default setting", "ethereum/client-go:v1.8.27", false, config.Mode_Local, "sigp/lighthouse:v3.3.0", defaultSuggestedBlockGasLimit, []string{}},
+		{"a Geth tag without a version", "ethereum/client-go:latest", true, config.Mode_Local, "sigp/lighthouse:v3.3.0", defaultSuggestedBlockGasLimit, []string{}},
+		{"an external Execution client", "ethereum/client-go:v1.8.27", true, config.Mode_External, "sigp/lighthouse:v3.3.0", defaultSuggestedBlockGasLimit, []string{}},
+		{"a flag unsupported by the pinned Lighthouse version", "ethereum/client-go:v1.10.26", false, config.Mode_Local, "sigp/lighthouse:v2.3.1-modern", 40000000, []string{"Lighthouse v2.4.0"}},
+		{"both clients too old", "ethereum/client-go:v1.8.27", true, config.Mode_Local, "sigp/lighthouse:v2.3.1", 40000000, []string{"Geth v1.9.0", "Lighthouse v2.4.0"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ExecutionClientMode.Value = test.ecMode
+			cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+			cfg.Geth.ContainerTag.Value = test.gethTag
+			cfg.Geth.EnableExpensiveMetrics.Value = test.expensiveMetrics
+			cfg.ConsensusClient.Value = config.ConsensusClient_Lighthouse
+			cfg.Lighthouse.ContainerTag.Value = test.lighthouseTag
+			cfg.ConsensusCommon.SuggestedBlockGasLimit.Value = test.gasLimit
+
+			issues := cfg.ValidateAgainstClientVersions()
+			if len(issues) != len(test.expectedClients) {
+				t.Fatalf("expected %d issues, got %v", len(test.expectedClients), issues)
+			}
+			for i, issue := range issues {
+				if issue.Severity != config.IssueSeverity_Error {
+					t.Errorf("expected an error, got %s", issue.Severity)
+				}
+				if !strings.Contains(issue.Message, test.expectedClients[i]) {
+					t.Errorf("expected the issue to mention %s, got [%s]", test.expectedClients[i], issue.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetContainerTagVersion(t *testing.T) {
+	tests := []struct {
+		containerTag string
+		expected     string
+	}{
+		{"ethereum/client-go:v1.10.26", "1.10.26"},
+		{"sigp/lighthouse:v3.3.0-modern", "3.3.0"},
+		{"consensys/teku:22.12.0-openjdk-latest", "22.12.0"},
+		{"ethereum/client-go:latest", ""},
+		{"ethereum/client-go", ""},
+	}
+
+	for _, test := range tests {
+		clientVersion := getContainerTagVersion(test.containerTag)
+		actual := ""
+		if clientVersion != nil {
+			actual = clientVersion.String()
+		}
+		if actual != test.expected {
+			t.Errorf("expected the version of %s to be [%s], got [%s]", test.containerTag, test.expected, actual)
+		}
+	}
+}
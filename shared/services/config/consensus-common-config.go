@@ -1,24 +1,42 @@
 package config
 
 import (
+	"fmt"
+	"net/url"
+	"runtime"
+
 	"github.com/rocket-pool/smartnode/shared/types/config"
 )
 
 // Param IDs
 const GraffitiID string = "graffiti"
 const CheckpointSyncUrlID string = "checkpointSyncUrl"
+const CheckpointVerifyRootID string = "checkpointVerifyRoot"
 const P2pPortID string = "p2pPort"
 const ApiPortID string = "apiPort"
 const OpenApiPortID string = "openApiPort"
 const DoppelgangerDetectionID string = "doppelgangerDetection"
+const SuggestedBlockGasLimitID string = "suggestedBlockGasLimit"
+const KeyManagerConcurrencyID string = "keyManagerConcurrency"
+const PruneHistoricalStatesID string = "pruneHistoricalStates"
 
 // Defaults
 const defaultGraffiti string = ""
 const defaultCheckpointSyncProvider string = ""
+const defaultCheckpointVerifyRoot string = ""
 const defaultP2pPort uint16 = 9001
 const defaultBnApiPort uint16 = 5052
 const defaultOpenBnApiPort bool = false
 const defaultDoppelgangerDetection bool = true
+const defaultSuggestedBlockGasLimit uint64 = 30000000
+const defaultPruneHistoricalStates bool = false
+
+// Limits
+const minSuggestedBlockGasLimit uint64 = 5000000
+const maxSuggestedBlockGasLimit uint64 = 100000000
+const minKeyManagerConcurrency uint64 = 1
+const maxKeyManagerConcurrency uint64 = 64
+const maxDefaultKeyManagerConcurrency uint64 = 16
 
 // Env var names
 const CustomGraffitiEnvVar string = "CUSTOM_GRAFFITI"
@@ -33,9 +51,15 @@ type ConsensusCommonConfig struct {
 	// The checkpoint sync URL if used
 	CheckpointSyncProvider config.Parameter `yaml:"checkpointSyncProvider,omitempty"`
 
+	// The trusted block root to verify the checkpoint sync state against
+	CheckpointVerifyRoot config.Parameter `yaml:"checkpointVerifyRoot,omitempty"`
+
 	// The port to use for gossip traffic
 	P2pPort config.Parameter `yaml:"p2pPort,omitempty"`
 
+	// Custom bootnodes
+	Bootnodes config.Parameter `yaml:"bootnodes,omitempty"`
+
 	// The port to expose the HTTP API on
 	ApiPort config.Parameter `yaml:"apiPort,omitempty"`
 
@@ -44,6 +68,24 @@ type ConsensusCommonConfig struct {
 
 	// Toggle for enabling doppelganger detection
 	DoppelgangerDetection config.Parameter `yaml:"doppelgangerDetection,omitempty"`
+
+	// The block gas limit the Validator Client should signal for the blocks it proposes
+	SuggestedBlockGasLimit config.Parameter `yaml:"suggestedBlockGasLimit,omitempty"`
+
+	// The number of workers the Validator Client's key manager API uses for key operations
+	KeyManagerConcurrency config.Parameter `yaml:"keyManagerConcurrency,omitempty"`
+
+	// Per-validator overrides for the builder API registration settings
+	BuilderRegistrationOverrides config.Parameter `yaml:"builderRegistrationOverrides,omitempty"`
+
+	// Toggle for pruning the Beacon Node's historical states
+	PruneHistoricalStates config.Parameter `yaml:"pruneHistoricalStates,omitempty"`
+
+	// The max number of CPU cores the Beacon Node container can use
+	CpuLimit config.Parameter `yaml:"cpuLimit,omitempty"`
+
+	// The max amount of RAM the Beacon Node container can use
+	MemoryLimit config.Parameter `yaml:"memoryLimit,omitempty"`
 }
 
 // Create a new ConsensusCommonParams struct
@@ -69,13 +111,32 @@ func NewConsensusCommonConfig(cfg *RocketPoolConfig) *ConsensusCommonConfig {
 			Name: "Checkpoint Sync URL",
 			Description: "If you would like to instantly sync using an existing Beacon node, enter its URL.\n" +
 				"Example: https://<project ID>:<secret>@eth2-beacon-prater.infura.io\n" +
-				"Leave this blank if you want to sync normally from the start of the chain.",
+				"Leave this blank if you want to sync normally from the start of the chain.\n" +
+				"On Mainnet, the URL must use HTTPS so the state can't be tampered with on the way to your node.",
 			Type:                 config.ParameterType_String,
 			Default:              map[config.Network]interface{}{config.Network_All: defaultCheckpointSyncProvider},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
 			EnvironmentVariables: []string{"CHECKPOINT_SYNC_URL"},
 			CanBeBlank:           true,
 			OverwriteOnUpgrade:   false,
+			NetworkConstraints: map[config.Network]func(interface{}) error{
+				config.Network_Mainnet: requireHttpsUrl,
+			},
+		},
+
+		CheckpointVerifyRoot: config.Parameter{
+			ID:   CheckpointVerifyRootID,
+			Name: "Checkpoint Verification Root",
+			Description: "If you use Checkpoint Sync, you can enter the root of a block you trust here (such as one from a block explorer or a friend's node) as a 0x-prefixed, 32-byte hex string. Your client will verify that the state it downloads matches it, so a malicious checkpoint provider can't trick it.\n" +
+				"This is currently only used by Nimbus; other clients ignore it.\n" +
+				"Leave this blank to trust the Checkpoint Sync provider.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultCheckpointVerifyRoot},
+			Regex:                "^0x[0-9a-fA-F]{64}$",
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
 		},
 
 		P2pPort: config.Parameter{
@@ -90,6 +151,8 @@ func NewConsensusCommonConfig(cfg *RocketPoolConfig) *ConsensusCommonConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		Bootnodes: generateBootnodesParameter("Consensus", "ENR", config.ContainerID_Eth2, "BN_BOOTNODES"),
+
 		ApiPort: config.Parameter{
 			ID:                   ApiPortID,
 			Name:                 "HTTP API Port",
@@ -125,6 +188,51 @@ func NewConsensusCommonConfig(cfg *RocketPoolConfig) *ConsensusCommonConfig {
 			CanBeBlank:           false,
 			OverwriteOnUpgrade:   false,
 		},
+
+		SuggestedBlockGasLimit: config.Parameter{
+			ID:                   SuggestedBlockGasLimitID,
+			Name:                 "Suggested Block Gas Limit",
+			Description:          fmt.Sprintf("The block gas limit your Validator Client will ask block builders to target for the blocks you propose. The network's gas limit slowly moves towards the value most validators signal.\n\nMust be between %d and %d. Only change this if you know what you're doing.", minSuggestedBlockGasLimit, maxSuggestedBlockGasLimit),
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultSuggestedBlockGasLimit},
+			MinValue:             minSuggestedBlockGasLimit,
+			MaxValue:             maxSuggestedBlockGasLimit,
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator},
+			EnvironmentVariables: []string{"VC_SUGGESTED_GAS_LIMIT"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		KeyManagerConcurrency: config.Parameter{
+			ID:                   KeyManagerConcurrencyID,
+			Name:                 "Key Manager Workers",
+			Description:          fmt.Sprintf("The number of workers the Validator Client's key manager API uses when importing, exporting, or deleting validator keys. Raising this can speed those operations up considerably on nodes with hundreds of validators.\n\nMust be between %d and %d. The default is based on the number of CPU cores your system has.", minKeyManagerConcurrency, maxKeyManagerConcurrency),
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: calculateKeyManagerConcurrency()},
+			MinValue:             minKeyManagerConcurrency,
+			MaxValue:             maxKeyManagerConcurrency,
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator},
+			EnvironmentVariables: []string{"VC_KEYMANAGER_CONCURRENCY"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		BuilderRegistrationOverrides: generateBuilderRegistrationOverridesParameter(),
+
+		PruneHistoricalStates: config.Parameter{
+			ID:                   PruneHistoricalStatesID,
+			Name:                 "Prune Historical States",
+			Description:          "Enable this to have your Consensus client store as few historical Beacon chain states as it can, which saves disk space.\n\n[orange]NOTE: Your client won't be able to answer queries about the state of the chain at older blocks, which some tools (such as manual Merkle rewards tree generation) need.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultPruneHistoricalStates},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CpuLimit:    generateCpuLimitParameter("Beacon Node", config.ContainerID_Eth2, "BN_CPU_LIMIT"),
+		MemoryLimit: generateMemoryLimitParameter("Beacon Node", config.ContainerID_Eth2, "BN_MEMORY_LIMIT"),
 	}
 }
 
@@ -133,10 +241,18 @@ func (cfg *ConsensusCommonConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.Graffiti,
 		&cfg.CheckpointSyncProvider,
+		&cfg.CheckpointVerifyRoot,
 		&cfg.P2pPort,
+		&cfg.Bootnodes,
 		&cfg.ApiPort,
 		&cfg.OpenApiPort,
 		&cfg.DoppelgangerDetection,
+		&cfg.SuggestedBlockGasLimit,
+		&cfg.KeyManagerConcurrency,
+		&cfg.BuilderRegistrationOverrides,
+		&cfg.PruneHistoricalStates,
+		&cfg.CpuLimit,
+		&cfg.MemoryLimit,
 	}
 }
 
@@ -144,3 +260,75 @@ func (cfg *ConsensusCommonConfig) GetParameters() []*config.Parameter {
 func (cfg *ConsensusCommonConfig) GetConfigTitle() string {
 	return cfg.Title
 }
+
+// Get the command line flag that makes a Consensus client verify its checkpoint sync state against the given block root.
+// Returns an empty string if the client can't verify it by block root alone.
+func GetCheckpointVerifyRootFlag(client config.ConsensusClient, root string) string {
+	switch client {
+	case config.ConsensusClient_Nimbus:
+		return fmt.Sprintf("--trusted-block-root=%s", root)
+	default:
+		return ""
+	}
+}
+
+// Get the command line flag that sets the block gas limit a Consensus client's Validator Client signals
+func GetSuggestedGasLimitFlag(client config.ConsensusClient, gasLimit uint64) string {
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		return fmt.Sprintf("--gas-limit=%d", gasLimit)
+	case config.ConsensusClient_Nimbus, config.ConsensusClient_Prysm:
+		return fmt.Sprintf("--suggested-gas-limit=%d", gasLimit)
+	case config.ConsensusClient_Teku:
+		return fmt.Sprintf("--validators-builder-registration-default-gas-limit=%d", gasLimit)
+	case config.ConsensusClient_Lodestar:
+		return fmt.Sprintf("--defaultGasLimit=%d", gasLimit)
+	default:
+		return ""
+	}
+}
+
+// Get the command line flag that makes a Consensus client's Beacon Node keep as few historical states as it can.
+// Returns an empty string if the client has no way to prune them.
+func GetPruneHistoricalStatesFlag(client config.ConsensusClient) string {
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		return "--slots-per-restore-point=8192"
+	case config.ConsensusClient_Nimbus:
+		return "--history=prune"
+	case config.ConsensusClient_Prysm:
+		return "--slots-per-archive-point=8192"
+	case config.ConsensusClient_Teku:
+		return "--data-storage-mode=prune"
+	default:
+		return ""
+	}
+}
+
+// Calculate the default number of key manager workers, one per CPU core up to a reasonable limit
+func calculateKeyManagerConcurrency() uint64 {
+	workers := uint64(runtime.NumCPU())
+	if workers < minKeyManagerConcurrency {
+		return minKeyManagerConcurrency
+	}
+	if workers > maxDefaultKeyManagerConcurrency {
+		return maxDefaultKeyManagerConcurrency
+	}
+	return workers
+}
+
+// Checks that a URL (if one is set) uses HTTPS
+func requireHttpsUrl(value interface{}) error {
+	urlString, ok := value.(string)
+	if !ok || urlString == "" {
+		return nil
+	}
+	parsedUrl, err := url.Parse(urlString)
+	if err != nil {
+		return err
+	}
+	if parsedUrl.Scheme != "https" {
+		return fmt.Errorf("%s must use HTTPS", urlString)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package config
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestCheckpointSyncUrlNetworkConstraints(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		network config.Network
+		valid   bool
+	}{
+		{"blank on Mainnet", "", config.Network_Mainnet, true},
+		{"HTTPS on Mainnet", "https://beacon.example.com", config.Network_Mainnet, true},
+		{"HTTP on Mainnet", "http://beacon.example.com", config.Network_Mainnet, false},
+		{"HTTP on Prater", "http://beacon.example.com", config.Network_Prater, true},
+		{"HTTPS on Prater", "https://beacon.example.com", config.Network_Prater, true},
+		{"HTTP on Devnet", "http://beacon.example.com", config.Network_Devnet, true},
+	}
+
+	cfg := NewRocketPoolConfig("", false)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := cfg.ConsensusCommon.CheckpointSyncProvider.ValidateForNetwork(test.value, test.network)
+			if test.valid && err != nil {
+				t.Errorf("expected %s to be valid on %s, got error: %s", test.value, test.network, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %s to be rejected on %s", test.value, test.network)
+			}
+		})
+	}
+}
+
+func TestCheckpointVerifyRootValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"blank", "", true},
+		{"32-byte root", "0x" + strings.Repeat("ab", 32), true},
+		{"mixed case", "0x" + strings.Repeat("aB", 32), true},
+		{"missing 0x prefix", strings.Repeat("ab", 32), false},
+		{"too short", "0x" + strings.Repeat("ab", 31), false},
+		{"too long", "0x" + strings.Repeat("ab", 33), false},
+		{"not hex", "0x" + strings.Repeat("zz", 32), false},
+	}
+
+	cfg := NewRocketPoolConfig("", false)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := cfg.ConsensusCommon.CheckpointVerifyRoot.Validate(test.value)
+			if test.valid && err != nil {
+				t.Errorf("expected %s to be valid, got error: %s", test.value, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %s to be rejected", test.value)
+			}
+		})
+	}
+}
+
+func TestCheckpointVerifyRootFlag(t *testing.T) {
+	root := "0x" + strings.Repeat("ab", 32)
+
+	cfg := newTestConfig()
+	cfg.ConsensusClient.Value = config.ConsensusClient_Nimbus
+	cfg.ConsensusCommon.CheckpointSyncProvider.Value = "https://beacon.example.com"
+	cfg.ConsensusCommon.CheckpointVerifyRoot.Value = root
+	envVars := cfg.GenerateEnvironmentVariables()
+	expected := "--trusted-block-root=" + root
+	if envVars["CHECKPOINT_VERIFY_ROOT_FLAG"] != expected {
+		t.Errorf("expected CHECKPOINT_VERIFY_ROOT_FLAG to be [%s], got [%s]", expected, envVars["CHECKPOINT_VERIFY_ROOT_FLAG"])
+	}
+
+	cfg.ConsensusClient.Value = config.ConsensusClient_Lighthouse
+	envVars = cfg.GenerateEnvironmentVariables()
+	if envVars["CHECKPOINT_VERIFY_ROOT_FLAG"] != "" {
+		t.Errorf("expected no CHECKPOINT_VERIFY_ROOT_FLAG for Lighthouse, got [%s]", envVars["CHECKPOINT_VERIFY_ROOT_FLAG"])
+	}
+}
+
+func TestCheckCheckpointSyncVerification(t *testing.T) {
+	tests := []struct {
+		name         string
+		client       config.ConsensusClient
+		syncUrl      string
+		verifyRoot   string
+		expectWarned bool
+	}{
+		{"Nimbus without a root", config.ConsensusClient_Nimbus, "https://beacon.example.com", "", true},
+		{"Nimbus with a root", config.ConsensusClient_Nimbus, "https://beacon.example.com", "0x" + strings.Repeat("ab", 32), false},
+		{"Nimbus without checkpoint sync", config.ConsensusClient_Nimbus, "", "", false},
+		{"Lighthouse without a root", config.ConsensusClient_Lighthouse, "https://beacon.example.com", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ConsensusClient.Value = test.client
+			cfg.ConsensusCommon.CheckpointSyncProvider.Value = test.syncUrl
+			cfg.ConsensusCommon.CheckpointVerifyRoot.Value = test.verifyRoot
+			warnings := cfg.CheckCheckpointSyncVerification()
+			if test.expectWarned && len(warnings) != 1 {
+				t.Errorf("expected a missing-root warning, got %v", warnings)
+			}
+			if !test.expectWarned && len(warnings) != 0 {
+				t.Errorf("expected no warnings, got %v", warnings)
+			}
+		})
+	}
+}
+
+func TestSuggestedBlockGasLimit(t *testing.T) {
+	cfg := newTestConfig()
+	if cfg.ConsensusCommon.SuggestedBlockGasLimit.Value != uint64(30000000) {
+		t.Errorf("expected the suggested gas limit to default to 30000000, got %v", cfg.ConsensusCommon.SuggestedBlockGasLimit.Value)
+	}
+
+	tests := []struct {
+		name     string
+		gasLimit uint64
+		valid    bool
+	}{
+		{"default", defaultSuggestedBlockGasLimit, true},
+		{"too low", minSuggestedBlockGasLimit - 1, false},
+		{"minimum", minSuggestedBlockGasLimit, true},
+		{"maximum", maxSuggestedBlockGasLimit, true},
+		{"too high", maxSuggestedBlockGasLimit + 1, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := cfg.ConsensusCommon.SuggestedBlockGasLimit.Validate(test.gasLimit)
+			if test.valid && err != nil {
+				t.Errorf("expected %d to be valid, got error: %s", test.gasLimit, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %d to be rejected", test.gasLimit)
+			}
+		})
+	}
+}
+
+func TestSuggestedBlockGasLimitFlag(t *testing.T) {
+	tests := []struct {
+		client   config.ConsensusClient
+		expected string
+	}{
+		{config.ConsensusClient_Lighthouse, "--gas-limit=30000000"},
+		{config.ConsensusClient_Nimbus, "--suggested-gas-limit=30000000"},
+		{config.ConsensusClient_Prysm, "--suggested-gas-limit=30000000"},
+		{config.ConsensusClient_Teku, "--validators-builder-registration-default-gas-limit=30000000"},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.client), func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ConsensusClient.Value = test.client
+			flag := cfg.GenerateEnvironmentVariables()["VC_SUGGESTED_GAS_LIMIT_FLAG"]
+			if flag != test.expected {
+				t.Errorf("expected [%s], got [%s]", test.expected, flag)
+			}
+		})
+	}
+}
+
+func TestKeyManagerConcurrency(t *testing.T) {
+	expectedDefault := uint64(runtime.NumCPU())
+	if expectedDefault > maxDefaultKeyManagerConcurrency {
+		expectedDefault = maxDefaultKeyManagerConcurrency
+	}
+	if calculateKeyManagerConcurrency() != expectedDefault {
+		t.Errorf("expected %d workers by default for %d CPU cores, got %d", expectedDefault, runtime.NumCPU(), calculateKeyManagerConcurrency())
+	}
+
+	cfg := newTestConfig()
+	envVar := cfg.GenerateEnvironmentVariables()["VC_KEYMANAGER_CONCURRENCY"]
+	if envVar != fmt.Sprint(expectedDefault) {
+		t.Errorf("expected VC_KEYMANAGER_CONCURRENCY to be [%d], got [%s]", expectedDefault, envVar)
+	}
+
+	tests := []struct {
+		workers uint64
+		valid   bool
+	}{
+		{0, false},
+		{1, true},
+		{16, true},
+		{64, true},
+		{65, false},
+	}
+	for _, test := range tests {
+		err := cfg.ConsensusCommon.KeyManagerConcurrency.Validate(test.workers)
+		if test.valid && err != nil {
+			t.Errorf("expected %d workers to be valid, got error: %s", test.workers, err.Error())
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected %d workers to be rejected", test.workers)
+		}
+	}
+}
+
+func TestPruneHistoricalStates(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      config.ConsensusClient
+		prune       bool
+		tekuArchive bool
+		flag        string
+		warning     string
+	}{
+		{"disabled", config.ConsensusClient_Lighthouse, false, false, "", ""},
+		{"Lighthouse", config.ConsensusClient_Lighthouse, true, false, "--slots-per-restore-point=8192", "won't be able to answer queries"},
+		{"Teku", config.ConsensusClient_Teku, true, false, "--data-storage-mode=prune", "won't be able to answer queries"},
+		{"Teku in archive mode", config.ConsensusClient_Teku, true, true, "", "Archive mode takes priority"},
+		{"Lodestar", config.ConsensusClient_Lodestar, true, false, "", "doesn't support it"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ConsensusClient.Value = test.client
+			cfg.ConsensusCommon.PruneHistoricalStates.Value = test.prune
+			cfg.Teku.ArchiveMode.Value = test.tekuArchive
+
+			flag := cfg.GenerateEnvironmentVariables()["BN_PRUNE_HISTORICAL_STATES_FLAG"]
+			if flag != test.flag {
+				t.Errorf("expected [%s], got [%s]", test.flag, flag)
+			}
+
+			warnings := cfg.CheckHistoricalStatePruning()
+			if test.warning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], test.warning) {
+				t.Errorf("expected a warning containing [%s], got %v", test.warning, warnings)
+			}
+		})
+	}
+}
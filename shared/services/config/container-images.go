@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Docker Hub's registry, which is implied when an image reference doesn't name one
+const (
+	defaultRegistry         string = "docker.io"
+	legacyDefaultRegistry   string = "index.docker.io"
+	officialImageRepoPrefix string = "library/"
+)
+
+// The Docker image reference grammar, as used by the Docker distribution project
+const (
+	domainComponentPattern string = `(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])`
+	domainPattern          string = domainComponentPattern + `(?:\.` + domainComponentPattern + `)*(?::[0-9]+)?`
+	pathComponentPattern   string = `[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*`
+	tagPattern             string = `[\w][\w.-]{0,127}`
+	digestPattern          string = `[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}`
+)
+
+var imageReferenceRegex = regexp.MustCompile(
+	`^(?:(` + domainPattern + `)/)?(` + pathComponentPattern + `(?:/` + pathComponentPattern + `)*)` +
+		`(?::(` + tagPattern + `))?(?:@(` + digestPattern + `))?$`,
+)
+
+// Cleans up a user-entered container image tag (such as `sigp/lighthouse:v3.3.0`) so it can be stored.
+// Surrounding whitespace is removed, the repository is lowercased, and images on Docker Hub are written without the implied
+// `docker.io/` and `library/` prefixes. An error is returned if the result isn't a valid Docker image reference.
+func NormalizeImageTag(tag string) (string, error) {
+	trimmed := strings.TrimSpace(tag)
+	if trimmed == "" {
+		return "", fmt.Errorf("the image tag cannot be blank")
+	}
+
+	// Split off the tag and digest first, since the tag is case-sensitive but the repository isn't
+	name := trimmed
+	suffix := ""
+	if index := strings.Index(name, "@"); index >= 0 {
+		suffix = name[index:]
+		name = name[:index]
+	}
+	if index := strings.LastIndex(name, ":"); index > strings.LastIndex(name, "/") {
+		suffix = name[index:] + suffix
+		name = name[:index]
+	}
+	name = strings.ToLower(name)
+
+	// Remove the implied Docker Hub registry
+	for _, registry := range []string{defaultRegistry, legacyDefaultRegistry} {
+		if strings.HasPrefix(name, registry+"/") {
+			name = strings.TrimPrefix(name, registry+"/")
+			if strings.HasPrefix(name, officialImageRepoPrefix) && strings.Count(name, "/") == 1 {
+				name = strings.TrimPrefix(name, officialImageRepoPrefix)
+			}
+			break
+		}
+	}
+
+	normalized := name + suffix
+	if !imageReferenceRegex.MatchString(normalized) {
+		return "", fmt.Errorf("[%s] is not a valid Docker image tag", trimmed)
+	}
+	return normalized, nil
+}
+
+// Checks that a container tag parameter holds a valid image reference
+func ValidateContainerImage(param *config.Parameter) error {
+	value, ok := param.Value.(string)
+	if !ok {
+		return fmt.Errorf("the value of [%s] is not a string", param.Name)
+	}
+	_, err := NormalizeImageTag(value)
+	if err != nil {
+		return fmt.Errorf("invalid container tag for [%s]: %w", param.Name, err)
+	}
+	return nil
+}
+
+// Replaces the value of a container tag parameter with its normalized form. Parameters that aren't container tags are
+// ignored, and invalid tags are left as they are so ValidateContainerImage can report them.
+func NormalizeContainerImage(param *config.Parameter) {
+	if !isContainerTagParameter(param) {
+		return
+	}
+	value, ok := param.Value.(string)
+	if !ok {
+		return
+	}
+	normalized, err := NormalizeImageTag(value)
+	if err == nil {
+		param.Value = normalized
+	}
+}
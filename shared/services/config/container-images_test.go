@@ -0,0 +1,83 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeImageTag(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("0123456789abcdef", 4)
+
+	tests := []struct {
+		name     string
+		tag      string
+		expected string
+		valid    bool
+	}{
+		{"already normalized", "sigp/lighthouse:v3.3.0", "sigp/lighthouse:v3.3.0", true},
+		{"surrounding whitespace", "  sigp/lighthouse:v3.3.0\n", "sigp/lighthouse:v3.3.0", true},
+		{"uppercase repository", "SigP/Lighthouse:v3.3.0", "sigp/lighthouse:v3.3.0", true},
+		{"tag case is kept", "sigp/lighthouse:V3.3.0-RC", "sigp/lighthouse:V3.3.0-RC", true},
+		{"docker hub registry", "docker.io/sigp/lighthouse:v3.3.0", "sigp/lighthouse:v3.3.0", true},
+		{"legacy docker hub registry", "index.docker.io/sigp/lighthouse:v3.3.0", "sigp/lighthouse:v3.3.0", true},
+		{"official image", "docker.io/library/ubuntu:22.04", "ubuntu:22.04", true},
+		{"nested library repository", "docker.io/library/foo/bar", "library/foo/bar", true},
+		{"no tag", "sigp/lighthouse", "sigp/lighthouse", true},
+		{"other registry", "ghcr.io/foo/bar:latest", "ghcr.io/foo/bar:latest", true},
+		{"registry with port", "localhost:5000/foo:1.0", "localhost:5000/foo:1.0", true},
+		{"digest", "sigp/lighthouse@" + digest, "sigp/lighthouse@" + digest, true},
+		{"tag and digest", "docker.io/sigp/lighthouse:v3.3.0@" + digest, "sigp/lighthouse:v3.3.0@" + digest, true},
+		{"blank", "", "", false},
+		{"whitespace only", "   ", "", false},
+		{"space in the name", "sigp/light house:v3.3.0", "", false},
+		{"invalid tag", "sigp/lighthouse:v3.3.0!", "", false},
+		{"empty tag", "sigp/lighthouse:", "", false},
+		{"short digest", "sigp/lighthouse@sha256:abc", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			normalized, err := NormalizeImageTag(test.tag)
+			if test.valid && err != nil {
+				t.Fatalf("expected [%s] to be valid, got error: %s", test.tag, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Fatalf("expected [%s] to be rejected, got %s", test.tag, normalized)
+			}
+			if normalized != test.expected {
+				t.Errorf("expected [%s] to normalize to [%s], got [%s]", test.tag, test.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestValidateAndNormalizeContainerImage(t *testing.T) {
+	cfg := newTestConfig()
+
+	cfg.Geth.ContainerTag.Value = " docker.io/Ethereum/client-go:v1.10.26 "
+	if err := ValidateContainerImage(&cfg.Geth.ContainerTag); err != nil {
+		t.Fatalf("expected the container tag to be valid, got error: %s", err.Error())
+	}
+	if cfg.Geth.ContainerTag.Value != " docker.io/Ethereum/client-go:v1.10.26 " {
+		t.Errorf("expected validation to leave the container tag unchanged, got [%v]", cfg.Geth.ContainerTag.Value)
+	}
+	NormalizeContainerImage(&cfg.Geth.ContainerTag)
+	if cfg.Geth.ContainerTag.Value != "ethereum/client-go:v1.10.26" {
+		t.Errorf("expected the container tag to be normalized, got [%v]", cfg.Geth.ContainerTag.Value)
+	}
+
+	cfg.Geth.ContainerTag.Value = "ethereum/client go"
+	if err := ValidateContainerImage(&cfg.Geth.ContainerTag); err == nil {
+		t.Error("expected an invalid container tag to be rejected")
+	}
+	NormalizeContainerImage(&cfg.Geth.ContainerTag)
+	if cfg.Geth.ContainerTag.Value != "ethereum/client go" {
+		t.Errorf("expected an invalid container tag to be left as it is, got [%v]", cfg.Geth.ContainerTag.Value)
+	}
+
+	cfg.ExecutionCommon.EthstatsLabel.Value = " Docker.io/Label "
+	NormalizeContainerImage(&cfg.ExecutionCommon.EthstatsLabel)
+	if cfg.ExecutionCommon.EthstatsLabel.Value != " Docker.io/Label " {
+		t.Errorf("expected a parameter that isn't a container tag to be ignored, got [%v]", cfg.ExecutionCommon.EthstatsLabel.Value)
+	}
+}
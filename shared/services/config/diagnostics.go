@@ -0,0 +1,114 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Information about the machine the Smartnode is running on
+type diagnosticsSystemInfo struct {
+	OS            string `json:"os"`
+	Arch          string `json:"arch"`
+	CpuCores      int    `json:"cpuCores"`
+	TotalMemoryMb uint64 `json:"totalMemoryMb"`
+}
+
+// A single artifact with everything support needs to troubleshoot a node
+type diagnosticsBundle struct {
+	SmartnodeVersion string                       `json:"smartnodeVersion"`
+	System           diagnosticsSystemInfo        `json:"system"`
+	Config           map[string]map[string]string `json:"config"`
+	ContainerImages  map[string]string            `json:"containerImages"`
+	Errors           []string                     `json:"errors"`
+	Warnings         []string                     `json:"warnings"`
+	EnvDrift         []config.EnvDrift            `json:"envDrift,omitempty"`
+}
+
+// Generates a JSON bundle for troubleshooting that includes the redacted config, its validation errors and warnings, the
+// container images in use, information about the system, and (if envPath isn't empty) any drift between the config and the
+// environment variable file at envPath.
+// Secret parameters are redacted everywhere, including in the environment drift.
+func (cfg *RocketPoolConfig) DiagnosticsBundle(envPath string) ([]byte, error) {
+	// Everything that reports parameter values is run against the redacted copy so secrets can't leak into it. None of the
+	// checks modify the config or write anything to disk.
+	redacted := cfg.Redacted()
+
+	bundle := diagnosticsBundle{
+		SmartnodeVersion: shared.RocketPoolVersion,
+		System: diagnosticsSystemInfo{
+			OS:            runtime.GOOS,
+			Arch:          runtime.GOARCH,
+			CpuCores:      runtime.NumCPU(),
+			TotalMemoryMb: totalMemory() / 1024 / 1024,
+		},
+		Config:          redacted.Serialize(),
+		ContainerImages: redacted.getActiveContainerImages(),
+		Errors:          redacted.Validate(),
+		Warnings:        redacted.GetWarnings(),
+	}
+
+	if envPath != "" {
+		drift, err := cfg.DetectEnvDrift(envPath)
+		if err != nil {
+			return nil, fmt.Errorf("error checking environment drift: %w", err)
+		}
+		bundle.EnvDrift = cfg.redactEnvDrift(drift)
+	}
+
+	bytes, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error serializing diagnostics bundle: %w", err)
+	}
+	return bytes, nil
+}
+
+// Get the container tags of the active parameters, keyed by their settable paths (such as `geth.containerTag`)
+func (cfg *RocketPoolConfig) getActiveContainerImages() map[string]string {
+	images := map[string]string{}
+	for section, params := range cfg.getActiveParameters() {
+		for _, param := range params {
+			if isContainerTagParameter(param) {
+				images[getSettablePath(section, param).Path] = fmt.Sprint(param.Value)
+			}
+		}
+	}
+	return images
+}
+
+// Replaces the values of environment variables that come from secret parameters with a placeholder, and redacts the ones
+// that come from client URLs the same way Redacted() does. Variables that the config doesn't generate have their values
+// removed entirely, since there's no way to tell what they hold.
+func (cfg *RocketPoolConfig) redactEnvDrift(drift []config.EnvDrift) []config.EnvDrift {
+	redactedDrift := make([]config.EnvDrift, 0, len(drift))
+	for _, entry := range drift {
+		if entry.Type == config.EnvDriftType_Extra {
+			entry.ActualValue = ""
+		}
+		for _, param := range cfg.ParametersForEnvVar(entry.Name) {
+			if param.Secret {
+				if entry.ExpectedValue != "" {
+					entry.ExpectedValue = redactedValue
+				}
+				if entry.ActualValue != "" {
+					entry.ActualValue = redactedValue
+				}
+				break
+			}
+			if isUrlParameter(param) {
+				if entry.ExpectedValue != "" {
+					entry.ExpectedValue = redactUrl(entry.ExpectedValue)
+				}
+				if entry.ActualValue != "" {
+					entry.ActualValue = redactUrl(entry.ActualValue)
+				}
+				break
+			}
+		}
+		redactedDrift = append(redactedDrift, entry)
+	}
+	return redactedDrift
+}
@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// The number of weeks of chain growth the free disk space should be able to hold before a warning is shown
+const diskHeadroomWarningWeeks uint64 = 4
+
+// Estimated size of a fully synced Execution client's chain data, in GB, on Mainnet and on the test networks
+var ecMainnetChainSize = map[config.ExecutionClient]uint64{
+	config.ExecutionClient_Geth:       800,
+	config.ExecutionClient_Nethermind: 900,
+	config.ExecutionClient_Besu:       800,
+	config.ExecutionClient_Erigon:     2000,
+}
+var ecTestnetChainSize = map[config.ExecutionClient]uint64{
+	config.ExecutionClient_Geth:       250,
+	config.ExecutionClient_Nethermind: 300,
+	config.ExecutionClient_Besu:       250,
+	config.ExecutionClient_Erigon:     800,
+}
+
+// Estimated size of a fully synced Consensus client's chain data, in GB, on Mainnet and on the test networks
+var ccMainnetChainSize = map[config.ConsensusClient]uint64{
+	config.ConsensusClient_Lighthouse: 100,
+	config.ConsensusClient_Nimbus:     100,
+	config.ConsensusClient_Prysm:      120,
+	config.ConsensusClient_Teku:       100,
+	config.ConsensusClient_Lodestar:   120,
+}
+var ccTestnetChainSize = map[config.ConsensusClient]uint64{
+	config.ConsensusClient_Lighthouse: 100,
+	config.ConsensusClient_Nimbus:     100,
+	config.ConsensusClient_Prysm:      120,
+	config.ConsensusClient_Teku:       100,
+	config.ConsensusClient_Lodestar:   120,
+}
+
+// Estimated chain data growth for each Execution client, in GB per week, on Mainnet and on the test networks
+var ecMainnetWeeklyGrowth = map[config.ExecutionClient]uint64{
+	config.ExecutionClient_Geth:       14,
+	config.ExecutionClient_Nethermind: 20,
+	config.ExecutionClient_Besu:       7,
+	config.ExecutionClient_Erigon:     10,
+}
+var ecTestnetWeeklyGrowth = map[config.ExecutionClient]uint64{
+	config.ExecutionClient_Geth:       5,
+	config.ExecutionClient_Nethermind: 7,
+	config.ExecutionClient_Besu:       3,
+	config.ExecutionClient_Erigon:     4,
+}
+
+// Estimated chain data growth for each Consensus client, in GB per week, on Mainnet and on the test networks
+var ccMainnetWeeklyGrowth = map[config.ConsensusClient]uint64{
+	config.ConsensusClient_Lighthouse: 2,
+	config.ConsensusClient_Nimbus:     2,
+	config.ConsensusClient_Prysm:      3,
+	config.ConsensusClient_Teku:       2,
+	config.ConsensusClient_Lodestar:   3,
+}
+var ccTestnetWeeklyGrowth = map[config.ConsensusClient]uint64{
+	config.ConsensusClient_Lighthouse: 1,
+	config.ConsensusClient_Nimbus:     1,
+	config.ConsensusClient_Prysm:      2,
+	config.ConsensusClient_Teku:       1,
+	config.ConsensusClient_Lodestar:   2,
+}
+
+// Estimates how much space the chain data of the locally managed clients still needs to finish syncing and how quickly it will
+// grow afterwards, and compares that to the free disk space on the drive holding it. chainDataBytes is the amount of chain data
+// already on the drive, so a node that's partway through syncing only needs room for the rest of the chain. If the drive will
+// run out soon, returns a list of issues describing the problem.
+func (cfg *RocketPoolConfig) DiskHeadroomWarnings(freeBytes uint64, chainDataBytes uint64) []config.Issue {
+	issues := []config.Issue{}
+	if cfg.IsNativeMode {
+		return issues
+	}
+
+	ecSize := ecMainnetChainSize
+	ccSize := ccMainnetChainSize
+	ecGrowth := ecMainnetWeeklyGrowth
+	ccGrowth := ccMainnetWeeklyGrowth
+	if cfg.Smartnode.Network.Value.(config.Network) != config.Network_Mainnet {
+		ecSize = ecTestnetChainSize
+		ccSize = ccTestnetChainSize
+		ecGrowth = ecTestnetWeeklyGrowth
+		ccGrowth = ccTestnetWeeklyGrowth
+	}
+
+	// Add up the synced size and weekly growth of the locally managed clients, in GB
+	chainSize := uint64(0)
+	weeklyGrowth := uint64(0)
+	canPrune := false
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		executionClient := cfg.ExecutionClient.Value.(config.ExecutionClient)
+		chainSize += ecSize[executionClient]
+		weeklyGrowth += ecGrowth[executionClient]
+		canPrune = (executionClient == config.ExecutionClient_Geth || executionClient == config.ExecutionClient_Nethermind)
+	}
+	consensusClient, mode := cfg.GetSelectedConsensusClient()
+	if mode == config.Mode_Local {
+		chainSize += ccSize[consensusClient]
+		weeklyGrowth += ccGrowth[consensusClient]
+	}
+	if weeklyGrowth == 0 {
+		return issues
+	}
+
+	// Set aside the space needed to finish syncing
+	freeGb := freeBytes / 1024 / 1024 / 1024
+	chainDataGb := chainDataBytes / 1024 / 1024 / 1024
+	remainingSyncGb := uint64(0)
+	if chainDataGb < chainSize {
+		remainingSyncGb = chainSize - chainDataGb
+	}
+	if freeGb < remainingSyncGb {
+		issues = append(issues, config.Issue{
+			Severity: config.IssueSeverity_Error,
+			Message:  fmt.Sprintf("You have %d GB of free disk space, but your clients need about %d GB more to finish syncing the chain. Please free up space or move your chain data to a larger drive.", freeGb, remainingSyncGb),
+		})
+		return issues
+	}
+
+	weeksLeft := (freeGb - remainingSyncGb) / weeklyGrowth
+	if weeksLeft >= diskHeadroomWarningWeeks {
+		return issues
+	}
+
+	severity := config.IssueSeverity_Warning
+	if weeksLeft == 0 {
+		severity = config.IssueSeverity_Error
+	}
+	message := fmt.Sprintf("You have %d GB of free disk space, but your clients' chain data is expected to grow by about %d GB per week. You will likely run out of space in less than %d week(s).", freeGb, weeklyGrowth, weeksLeft+1)
+	if remainingSyncGb > 0 {
+		message = fmt.Sprintf("You have %d GB of free disk space, but your clients need about %d GB more to finish syncing the chain and will then grow by about %d GB per week. You will likely run out of space in less than %d week(s) after syncing.", freeGb, remainingSyncGb, weeklyGrowth, weeksLeft+1)
+	}
+	if canPrune && remainingSyncGb == 0 {
+		message += " Pruning your Execution client with `rocketpool service prune-eth1` will free up space."
+	} else {
+		message += " Please free up space or move your chain data to a larger drive."
+	}
+	issues = append(issues, config.Issue{
+		Severity: severity,
+		Message:  message,
+	})
+	return issues
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestDiskHeadroomWarnings(t *testing.T) {
+	const gigabyte uint64 = 1024 * 1024 * 1024
+	tests := []struct {
+		name        string
+		network     config.Network
+		freeGb      uint64
+		chainDataGb uint64
+		severities  []config.IssueSeverity
+	}{
+		{"unsynced Mainnet Geth with ample space", config.Network_Mainnet, 2000, 0, []config.IssueSeverity{}},
+		{"unsynced Mainnet Geth with less space than the chain", config.Network_Mainnet, 500, 0, []config.IssueSeverity{config.IssueSeverity_Error}},
+		{"unsynced Mainnet Geth with room to sync but not to grow", config.Network_Mainnet, 950, 0, []config.IssueSeverity{config.IssueSeverity_Warning}},
+		{"partly synced Mainnet Geth", config.Network_Mainnet, 600, 400, []config.IssueSeverity{}},
+		{"synced Mainnet Geth with ample space", config.Network_Mainnet, 500, 900, []config.IssueSeverity{}},
+		{"synced Mainnet Geth with low space", config.Network_Mainnet, 40, 900, []config.IssueSeverity{config.IssueSeverity_Warning}},
+		{"synced Mainnet Geth out of space", config.Network_Mainnet, 10, 900, []config.IssueSeverity{config.IssueSeverity_Error}},
+		{"unsynced Prater Geth", config.Network_Prater, 500, 0, []config.IssueSeverity{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Smartnode.Network.Value = test.network
+			cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+			cfg.ConsensusClient.Value = config.ConsensusClient_Lighthouse
+
+			issues := cfg.DiskHeadroomWarnings(test.freeGb*gigabyte, test.chainDataGb*gigabyte)
+			if len(issues) != len(test.severities) {
+				t.Fatalf("expected %d issues, got %v", len(test.severities), issues)
+			}
+			for i, issue := range issues {
+				if issue.Severity != test.severities[i] {
+					t.Errorf("expected a %s, got %s: %s", test.severities[i], issue.Severity, issue.Message)
+				}
+			}
+		})
+	}
+
+	cfg := newTestConfig()
+	cfg.ExecutionClientMode.Value = config.Mode_External
+	cfg.ConsensusClientMode.Value = config.Mode_External
+	if issues := cfg.DiskHeadroomWarnings(0, 0); len(issues) != 0 {
+		t.Errorf("expected no issues with external clients, got %v", issues)
+	}
+}
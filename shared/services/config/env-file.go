@@ -0,0 +1,310 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/alessio/shellescape"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Parses an environment variable file (such as a Docker Compose .env file) into a map of variable names to values.
+// Blank lines, comments, and `export` prefixes are ignored; values may be wrapped in single or double quotes.
+func ParseEnvFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open environment file at %s: %w", shellescape.Quote(path), err)
+	}
+	defer file.Close()
+
+	envVars := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		// Split the name and value
+		elements := strings.SplitN(line, "=", 2)
+		if len(elements) != 2 {
+			return nil, fmt.Errorf("error parsing line %d of %s: expected a NAME=VALUE pair", lineNumber, shellescape.Quote(path))
+		}
+		name := strings.TrimSpace(elements[0])
+		if name == "" {
+			return nil, fmt.Errorf("error parsing line %d of %s: variable name is blank", lineNumber, shellescape.Quote(path))
+		}
+		value, err := parseEnvValue(strings.TrimSpace(elements[1]))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing line %d of %s: %w", lineNumber, shellescape.Quote(path), err)
+		}
+		envVars[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading environment file at %s: %w", shellescape.Quote(path), err)
+	}
+
+	return envVars, nil
+}
+
+// Parses the value portion of an environment variable declaration, handling quotes and trailing comments
+func parseEnvValue(value string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+
+	switch value[0] {
+	case '\'':
+		// Single quotes are literal
+		end := strings.Index(value[1:], "'")
+		if end == -1 {
+			return "", fmt.Errorf("unterminated single-quoted value")
+		}
+		return value[1 : end+1], nil
+
+	case '"':
+		// Double quotes support escape sequences
+		builder := strings.Builder{}
+		for i := 1; i < len(value); i++ {
+			char := value[i]
+			switch char {
+			case '"':
+				return builder.String(), nil
+			case '\\':
+				if i+1 < len(value) {
+					i++
+					switch value[i] {
+					case 'n':
+						builder.WriteByte('\n')
+					case 't':
+						builder.WriteByte('\t')
+					default:
+						builder.WriteByte(value[i])
+					}
+					continue
+				}
+			}
+			builder.WriteByte(char)
+		}
+		return "", fmt.Errorf("unterminated double-quoted value")
+
+	default:
+		// Unquoted values end at the start of a comment
+		commentStart := strings.Index(value, " #")
+		if commentStart != -1 {
+			value = value[:commentStart]
+		}
+		return strings.TrimSpace(value), nil
+	}
+}
+
+// Applies a map of environment variables to the parameters of the currently selected clients and services.
+// A variable is only applied if its value is valid for every parameter that sets it, so the config is never left half-updated.
+// Returns the names of any variables that don't correspond to one of those parameters, along with descriptions of any
+// variables that had invalid values.
+func (cfg *RocketPoolConfig) ApplyEnvironment(envVars map[string]string) ([]string, []string) {
+	network := cfg.Smartnode.Network.Value.(config.Network)
+	envVarMap := cfg.getEnvironmentVariableMap()
+
+	unmappedVars := []string{}
+	invalidVars := []string{}
+	for name, value := range envVars {
+		params, exists := envVarMap[name]
+		if !exists {
+			unmappedVars = append(unmappedVars, name)
+			continue
+		}
+
+		// Check the value against every parameter before changing any of them
+		newValues := make([]interface{}, len(params))
+		var err error
+		for i, param := range params {
+			newValues[i], err = parseEnvValueForParameter(param, value, network)
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			invalidVars = append(invalidVars, fmt.Sprintf("%s: %s", name, err.Error()))
+			continue
+		}
+		for i, param := range params {
+			param.UpdateDescription(network)
+			param.Value = newValues[i]
+		}
+	}
+
+	sort.Strings(unmappedVars)
+	sort.Strings(invalidVars)
+	return unmappedVars, invalidVars
+}
+
+// Converts an environment variable's value into the given parameter's type and validates it, without changing the parameter
+func parseEnvValueForParameter(param *config.Parameter, value string, network config.Network) (interface{}, error) {
+	candidate := *param
+	err := candidate.Deserialize(map[string]string{param.ID: value}, network)
+	if err != nil {
+		return nil, err
+	}
+	err = param.ValidateForNetwork(candidate.Value, network)
+	if err != nil {
+		return nil, err
+	}
+	return candidate.Value, nil
+}
+
+// Get every parameter that sets the given environment variable, across all of the config's sections (including clients
+// and services that aren't currently selected). Several clients reuse the same variable names, so there may be more than one.
+func (cfg *RocketPoolConfig) ParametersForEnvVar(name string) []*config.Parameter {
+	params := []*config.Parameter{}
+	addMatches := func(sectionParams []*config.Parameter) {
+		for _, param := range sectionParams {
+			for _, envVar := range param.EnvironmentVariables {
+				if envVar == name {
+					params = append(params, param)
+					break
+				}
+			}
+		}
+	}
+
+	addMatches(cfg.GetParameters())
+	subconfigs := cfg.GetSubconfigs()
+	sectionNames := make([]string, 0, len(subconfigs))
+	for sectionName := range subconfigs {
+		sectionNames = append(sectionNames, sectionName)
+	}
+	sort.Strings(sectionNames)
+	for _, sectionName := range sectionNames {
+		addMatches(subconfigs[sectionName].GetParameters())
+	}
+
+	return params
+}
+
+// Get a map of environment variable names to the parameters of the currently selected clients and services that set them
+func (cfg *RocketPoolConfig) getEnvironmentVariableMap() map[string][]*config.Parameter {
+	envVarMap := map[string][]*config.Parameter{}
+	for _, params := range cfg.getActiveParameters() {
+		for _, param := range params {
+			for _, envVar := range param.EnvironmentVariables {
+				if envVar != "" {
+					envVarMap[envVar] = append(envVarMap[envVar], param)
+				}
+			}
+		}
+	}
+	return envVarMap
+}
+
+// Compares an environment variable file against the variables this configuration would generate, and reports any that have
+// different values, are missing from the file, or are in the file but not generated by the configuration
+func (cfg *RocketPoolConfig) DetectEnvDrift(envPath string) ([]config.EnvDrift, error) {
+	actualVars, err := ParseEnvFile(envPath)
+	if err != nil {
+		return nil, err
+	}
+	expectedVars := cfg.GenerateEnvironmentVariables()
+
+	drift := []config.EnvDrift{}
+	for name, expectedValue := range expectedVars {
+		actualValue, exists := actualVars[name]
+		if !exists {
+			drift = append(drift, config.EnvDrift{
+				Name:          name,
+				Type:          config.EnvDriftType_Missing,
+				ExpectedValue: expectedValue,
+			})
+		} else if actualValue != expectedValue {
+			drift = append(drift, config.EnvDrift{
+				Name:          name,
+				Type:          config.EnvDriftType_Changed,
+				ExpectedValue: expectedValue,
+				ActualValue:   actualValue,
+			})
+		}
+	}
+	for name, actualValue := range actualVars {
+		_, exists := expectedVars[name]
+		if !exists {
+			drift = append(drift, config.EnvDrift{
+				Name:        name,
+				Type:        config.EnvDriftType_Extra,
+				ActualValue: actualValue,
+			})
+		}
+	}
+
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Name < drift[j].Name
+	})
+	return drift, nil
+}
+
+// Generates the environment variables that a Docker Compose template references, such as the `${VAR}` entries in a
+// compose file. Returns the variables this configuration provides, along with the names of any requested variables it
+// can't provide (which usually means the template and the configuration are out of sync).
+func (cfg *RocketPoolConfig) GenerateEnvironmentFor(templateVars []string) (map[string]string, []string) {
+	allVars := cfg.GenerateEnvironmentVariables()
+
+	envVars := map[string]string{}
+	missingVars := []string{}
+	for _, name := range templateVars {
+		value, exists := allVars[name]
+		if !exists {
+			missingVars = append(missingVars, name)
+			continue
+		}
+		envVars[name] = value
+	}
+
+	sort.Strings(missingVars)
+	return envVars, missingVars
+}
+
+// Checks that every required parameter of the currently selected clients and services that affects the given container has
+// a value for the environment variables it sets; if any are blank, returns a list of issues describing them
+func (cfg *RocketPoolConfig) ValidateContainerEnvironment(container config.ContainerID) []config.Issue {
+	issues := []config.Issue{}
+	activeParams := cfg.getActiveParameters()
+
+	// Go through the sections in order so the issues are always reported the same way
+	sectionNames := make([]string, 0, len(activeParams))
+	for name := range activeParams {
+		sectionNames = append(sectionNames, name)
+	}
+	sort.Strings(sectionNames)
+
+	for _, sectionName := range sectionNames {
+		for _, param := range activeParams[sectionName] {
+			if param.CanBeBlank || len(param.EnvironmentVariables) == 0 || !affectsContainer(param, container) {
+				continue
+			}
+			if param.Value != nil && fmt.Sprint(param.Value) != "" {
+				continue
+			}
+			issues = append(issues, config.Issue{
+				Severity: config.IssueSeverity_Error,
+				Message:  fmt.Sprintf("[%s.%s] is required by the %s container, but it doesn't have a value (it sets %s).", sectionName, param.ID, container, strings.Join(param.EnvironmentVariables, ", ")),
+			})
+		}
+	}
+
+	return issues
+}
+
+// Check if a parameter affects the given container
+func affectsContainer(param *config.Parameter, container config.ContainerID) bool {
+	for _, affectedContainer := range param.AffectsContainers {
+		if affectedContainer == container {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,281 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Writes the given contents to an env file in a temporary directory, returning its path and a function to clean it up
+func writeTestEnvFile(t *testing.T, contents string) (string, func()) {
+	dir, err := ioutil.TempDir("", "rocketpool-env")
+	if err != nil {
+		t.Fatalf("error creating temporary directory: %s", err.Error())
+	}
+	path := filepath.Join(dir, ".env")
+	err = ioutil.WriteFile(path, []byte(contents), 0644)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("error writing env file: %s", err.Error())
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestParseEnvFile(t *testing.T) {
+	path, cleanup := writeTestEnvFile(t, `# Generated by the Smartnode
+
+export EC_HTTP_PORT=8555
+EC_WS_PORT = 8556 # the websocket port
+ETHSTATS_LABEL='my node # not a comment'
+ETHSTATS_LOGIN="node:secret\tpass\n"
+EC_ADDITIONAL_FLAGS="--foo \"bar\""
+CHECKPOINT_SYNC_URL=
+`)
+	defer cleanup()
+
+	envVars, err := ParseEnvFile(path)
+	if err != nil {
+		t.Fatalf("error parsing env file: %s", err.Error())
+	}
+
+	expected := map[string]string{
+		"EC_HTTP_PORT":        "8555",
+		"EC_WS_PORT":          "8556",
+		"ETHSTATS_LABEL":      "my node # not a comment",
+		"ETHSTATS_LOGIN":      "node:secret\tpass\n",
+		"EC_ADDITIONAL_FLAGS": "--foo \"bar\"",
+		"CHECKPOINT_SYNC_URL": "",
+	}
+	if len(envVars) != len(expected) {
+		t.Errorf("expected %d variables, got %d: %v", len(expected), len(envVars), envVars)
+	}
+	for name, value := range expected {
+		actual, exists := envVars[name]
+		if !exists {
+			t.Errorf("expected %s to be parsed", name)
+		} else if actual != value {
+			t.Errorf("expected %s to be [%s], got [%s]", name, value, actual)
+		}
+	}
+}
+
+func TestParseEnvFileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{"missing equals sign", "EC_HTTP_PORT 8545\n"},
+		{"blank name", "=8545\n"},
+		{"unterminated single quote", "ETHSTATS_LABEL='my node\n"},
+		{"unterminated double quote", "ETHSTATS_LABEL=\"my node\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, cleanup := writeTestEnvFile(t, test.contents)
+			defer cleanup()
+			if _, err := ParseEnvFile(path); err == nil {
+				t.Errorf("expected an error parsing %q", test.contents)
+			}
+		})
+	}
+
+	if _, err := ParseEnvFile(filepath.Join(os.TempDir(), "rocketpool-missing", ".env")); err == nil {
+		t.Error("expected an error parsing a missing file")
+	}
+}
+
+func TestApplyEnvironment(t *testing.T) {
+	path, cleanup := writeTestEnvFile(t, `# Hand-edited
+export EC_HTTP_PORT=8555
+ETHSTATS_LABEL="my node" # the label
+BN_API_PORT=not-a-port
+UNKNOWN_VARIABLE=1
+`)
+	defer cleanup()
+
+	envVars, err := ParseEnvFile(path)
+	if err != nil {
+		t.Fatalf("error parsing env file: %s", err.Error())
+	}
+
+	cfg := newTestConfig()
+	unmapped, invalid := cfg.ApplyEnvironment(envVars)
+
+	if cfg.ExecutionCommon.HttpPort.Value != uint16(8555) {
+		t.Errorf("expected the EC HTTP port to be 8555, got %v", cfg.ExecutionCommon.HttpPort.Value)
+	}
+	if cfg.ExecutionCommon.EthstatsLabel.Value != "my node" {
+		t.Errorf("expected the ethstats label to be [my node], got [%v]", cfg.ExecutionCommon.EthstatsLabel.Value)
+	}
+
+	if len(unmapped) != 1 || unmapped[0] != "UNKNOWN_VARIABLE" {
+		t.Errorf("expected UNKNOWN_VARIABLE to be the only unmapped variable, got %v", unmapped)
+	}
+	if len(invalid) != 1 || !strings.HasPrefix(invalid[0], "BN_API_PORT: ") {
+		t.Errorf("expected BN_API_PORT to be the only invalid variable, got %v", invalid)
+	}
+}
+
+func TestApplyEnvironmentWithSharedVariable(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+	originalPeers := cfg.Geth.MaxPeers.Value
+	originalLabel := cfg.ExecutionCommon.EthstatsLabel.Value
+
+	// Make the ethstats label share Geth's max peers variable; 0 is a valid label but below Geth's minimum peer count
+	cfg.ExecutionCommon.EthstatsLabel.EnvironmentVariables = append(cfg.ExecutionCommon.EthstatsLabel.EnvironmentVariables, "EC_MAX_PEERS")
+	unmapped, invalid := cfg.ApplyEnvironment(map[string]string{"EC_MAX_PEERS": "0"})
+
+	if cfg.Geth.MaxPeers.Value != originalPeers {
+		t.Errorf("expected Geth's max peers to stay at %v, got %v", originalPeers, cfg.Geth.MaxPeers.Value)
+	}
+	if cfg.ExecutionCommon.EthstatsLabel.Value != originalLabel {
+		t.Errorf("expected the ethstats label to stay at [%v], got [%v]", originalLabel, cfg.ExecutionCommon.EthstatsLabel.Value)
+	}
+	if len(unmapped) != 0 {
+		t.Errorf("expected no unmapped variables, got %v", unmapped)
+	}
+	if len(invalid) != 1 || !strings.HasPrefix(invalid[0], "EC_MAX_PEERS: ") {
+		t.Errorf("expected EC_MAX_PEERS to be the only invalid variable, got %v", invalid)
+	}
+
+	// A value that's valid for both parameters is applied to both of them
+	_, invalid = cfg.ApplyEnvironment(map[string]string{"EC_MAX_PEERS": "30"})
+	if len(invalid) != 0 {
+		t.Errorf("expected no invalid variables, got %v", invalid)
+	}
+	if cfg.Geth.MaxPeers.Value != uint16(30) {
+		t.Errorf("expected Geth's max peers to be 30, got %v", cfg.Geth.MaxPeers.Value)
+	}
+	if cfg.ExecutionCommon.EthstatsLabel.Value != "30" {
+		t.Errorf("expected the ethstats label to be [30], got [%v]", cfg.ExecutionCommon.EthstatsLabel.Value)
+	}
+}
+
+func TestDetectEnvDrift(t *testing.T) {
+	cfg := newTestConfig()
+	envVars := cfg.GenerateEnvironmentVariables()
+
+	// Hand-edit one value, drop one variable, and add an unknown one
+	envVars["EC_HTTP_PORT"] = "8555"
+	delete(envVars, "EC_WS_PORT")
+	envVars["UNKNOWN_VARIABLE"] = "1"
+
+	names := make([]string, 0, len(envVars))
+	for name := range envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`)
+	builder := strings.Builder{}
+	for _, name := range names {
+		builder.WriteString(fmt.Sprintf("%s=\"%s\"\n", name, escaper.Replace(envVars[name])))
+	}
+	path, cleanup := writeTestEnvFile(t, builder.String())
+	defer cleanup()
+
+	drift, err := cfg.DetectEnvDrift(path)
+	if err != nil {
+		t.Fatalf("error detecting drift: %s", err.Error())
+	}
+
+	expected := []config.EnvDrift{
+		{Name: "EC_HTTP_PORT", Type: config.EnvDriftType_Changed, ExpectedValue: "8545", ActualValue: "8555"},
+		{Name: "EC_WS_PORT", Type: config.EnvDriftType_Missing, ExpectedValue: "8546"},
+		{Name: "UNKNOWN_VARIABLE", Type: config.EnvDriftType_Extra, ActualValue: "1"},
+	}
+	if len(drift) != len(expected) {
+		t.Fatalf("expected drift %v, got %v", expected, drift)
+	}
+	for i := range expected {
+		if drift[i] != expected[i] {
+			t.Errorf("expected drift %v, got %v", expected[i], drift[i])
+		}
+	}
+}
+
+func TestDetectEnvDriftMissingFile(t *testing.T) {
+	cfg := newTestConfig()
+	if _, err := cfg.DetectEnvDrift(filepath.Join(os.TempDir(), "rocketpool-missing", ".env")); err == nil {
+		t.Error("expected an error detecting drift against a missing file")
+	}
+}
+
+func TestGenerateEnvironmentFor(t *testing.T) {
+	cfg := newTestConfig()
+	envVars, missingVars := cfg.GenerateEnvironmentFor([]string{"EC_HTTP_PORT", "UNKNOWN_TEMPLATE_VARIABLE", "EC_WS_PORT"})
+
+	expected := map[string]string{
+		"EC_HTTP_PORT": "8545",
+		"EC_WS_PORT":   "8546",
+	}
+	if len(envVars) != len(expected) {
+		t.Errorf("expected only the requested variables %v, got %v", expected, envVars)
+	}
+	for name, value := range expected {
+		if envVars[name] != value {
+			t.Errorf("expected %s to be [%s], got [%s]", name, value, envVars[name])
+		}
+	}
+
+	if len(missingVars) != 1 || missingVars[0] != "UNKNOWN_TEMPLATE_VARIABLE" {
+		t.Errorf("expected UNKNOWN_TEMPLATE_VARIABLE to be reported as missing, got %v", missingVars)
+	}
+}
+
+func TestValidateContainerEnvironment(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+	if issues := cfg.ValidateContainerEnvironment(config.ContainerID_Eth1); len(issues) != 0 {
+		t.Errorf("expected no issues with the default settings, got %v", issues)
+	}
+
+	cfg.Geth.ContainerTag.Value = ""
+	issues := cfg.ValidateContainerEnvironment(config.ContainerID_Eth1)
+	if len(issues) != 1 {
+		t.Fatalf("expected one issue for the blank Geth container tag, got %v", issues)
+	}
+	if issues[0].Severity != config.IssueSeverity_Error || !strings.Contains(issues[0].Message, "[geth.containerTag]") {
+		t.Errorf("expected an error about geth.containerTag, got %v", issues[0])
+	}
+
+	if issues := cfg.ValidateContainerEnvironment(config.ContainerID_Eth2); len(issues) != 0 {
+		t.Errorf("expected the blank Geth container tag not to affect the Eth2 container, got %v", issues)
+	}
+
+	cfg.ExecutionClient.Value = config.ExecutionClient_Nethermind
+	if issues := cfg.ValidateContainerEnvironment(config.ContainerID_Eth1); len(issues) != 0 {
+		t.Errorf("expected the blank Geth container tag to be ignored when Geth isn't selected, got %v", issues)
+	}
+}
+
+func TestParametersForEnvVar(t *testing.T) {
+	cfg := newTestConfig()
+
+	params := cfg.ParametersForEnvVar("EC_CONTAINER_TAG")
+	expected := []*config.Parameter{&cfg.Besu.ContainerTag, &cfg.Erigon.ContainerTag, &cfg.Geth.ContainerTag, &cfg.Nethermind.ContainerTag}
+	if len(params) != len(expected) {
+		t.Fatalf("expected %d parameters for EC_CONTAINER_TAG, got %d", len(expected), len(params))
+	}
+	for i, param := range params {
+		if param != expected[i] {
+			t.Errorf("expected parameter %d to be %s, got %s", i, expected[i].ID, param.ID)
+		}
+	}
+
+	params = cfg.ParametersForEnvVar("EC_HTTP_PORT")
+	if len(params) != 1 || params[0] != &cfg.ExecutionCommon.HttpPort {
+		t.Errorf("expected EC_HTTP_PORT to map to the EC HTTP port, got %v", params)
+	}
+
+	if params := cfg.ParametersForEnvVar("UNKNOWN_VARIABLE"); len(params) != 0 {
+		t.Errorf("expected no parameters for an unknown variable, got %v", params)
+	}
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Constants
+const (
+	erigonTagTest          string = "thorax/erigon:v2.35.2"
+	erigonTagProd          string = "thorax/erigon:v2.35.2"
+	erigonTagBeta          string = "thorax/erigon:stable"
+	erigonTagNightly       string = "thorax/erigon:devel"
+	erigonEventLogInterval int    = 25000
+	erigonMaxPeers         uint16 = 50
+	erigonStopSignal       string = "SIGINT"
+)
+
+// Configuration for Erigon
+type ErigonConfig struct {
+	Title string `yaml:"-"`
+
+	// Common parameters that Erigon doesn't support and should be hidden
+	UnsupportedCommonParams []string `yaml:"-"`
+
+	// Compatible consensus clients
+	CompatibleConsensusClients []config.ConsensusClient `yaml:"-"`
+
+	// The max number of events to query in a single event log query
+	EventLogInterval int `yaml:"-"`
+
+	// Max number of P2P peers to connect to
+	MaxPeers config.Parameter `yaml:"maxPeers,omitempty"`
+
+	// The log level for Erigon
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
+	// The Docker Hub tag for Erigon
+	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
+
+	// Custom command line flags
+	AdditionalFlags config.Parameter `yaml:"additionalFlags,omitempty"`
+}
+
+// Generates a new Erigon configuration
+func NewErigonConfig(cfg *RocketPoolConfig) *ErigonConfig {
+	return &ErigonConfig{
+		Title: "Erigon Settings",
+
+		// Erigon serves its Websocket API on the HTTP API port, so it doesn't have a separate one
+		UnsupportedCommonParams: []string{
+			ecWsPortID,
+		},
+
+		CompatibleConsensusClients: []config.ConsensusClient{
+			config.ConsensusClient_Lighthouse,
+			config.ConsensusClient_Nimbus,
+			config.ConsensusClient_Prysm,
+			config.ConsensusClient_Teku,
+			config.ConsensusClient_Lodestar,
+		},
+
+		EventLogInterval: erigonEventLogInterval,
+
+		MaxPeers: config.Parameter{
+			ID:                   "maxPeers",
+			Name:                 "Max Peers",
+			Description:          "The maximum number of peers Erigon should connect to. This can be lowered to improve performance on low-power systems or constrained networks. We recommend keeping it at 12 or higher.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: erigonMaxPeers},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
+			EnvironmentVariables: []string{"EC_MAX_PEERS"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		LogLevel: generateLogLevelParameter("Erigon", []config.ContainerID{config.ContainerID_Eth1}),
+
+		ContainerTag: config.Parameter{
+			ID:          "containerTag",
+			Name:        "Container Tag",
+			Description: "The tag name of the Erigon container you want to use on Docker Hub.",
+			Type:        config.ParameterType_String,
+			Default: map[config.Network]interface{}{
+				config.Network_Mainnet: erigonTagProd,
+				config.Network_Prater:  erigonTagTest,
+				config.Network_Devnet:  erigonTagTest,
+			},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
+			EnvironmentVariables: []string{"EC_CONTAINER_TAG"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   true,
+		},
+
+		AdditionalFlags: config.Parameter{
+			ID:                   "additionalFlags",
+			Name:                 "Additional Flags",
+			Description:          "Additional custom command line flags you want to pass to Erigon, to take advantage of other settings that the Smartnode's configuration doesn't cover.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
+			EnvironmentVariables: []string{"EC_ADDITIONAL_FLAGS"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *ErigonConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.MaxPeers,
+		&cfg.LogLevel,
+		&cfg.ContainerTag,
+		&cfg.AdditionalFlags,
+	}
+}
+
+// The the title for the config
+func (cfg *ErigonConfig) GetConfigTitle() string {
+	return cfg.Title
+}
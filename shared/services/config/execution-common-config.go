@@ -38,11 +38,20 @@ type ExecutionCommonConfig struct {
 	// P2P traffic port
 	P2pPort config.Parameter `yaml:"p2pPort,omitempty"`
 
+	// Custom bootnodes
+	Bootnodes config.Parameter `yaml:"bootnodes,omitempty"`
+
 	// Label for Ethstats
 	EthstatsLabel config.Parameter `yaml:"ethstatsLabel,omitempty"`
 
 	// Login info for Ethstats
 	EthstatsLogin config.Parameter `yaml:"ethstatsLogin,omitempty"`
+
+	// The max number of CPU cores the container can use
+	CpuLimit config.Parameter `yaml:"cpuLimit,omitempty"`
+
+	// The max amount of RAM the container can use
+	MemoryLimit config.Parameter `yaml:"memoryLimit,omitempty"`
 }
 
 // Create a new ExecutionCommonConfig struct
@@ -122,17 +131,24 @@ func NewExecutionCommonConfig(cfg *RocketPoolConfig) *ExecutionCommonConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		Bootnodes: generateBootnodesParameter("Execution", "enode or ENR", config.ContainerID_Eth1, "EC_BOOTNODES"),
+
 		EthstatsLogin: config.Parameter{
 			ID:                   "ethstatsLogin",
 			Name:                 "ETHStats Login",
-			Description:          "If you would like to report your Execution client statistics to https://ethstats.net/, enter the login you want to use here.",
+			Description:          "If you would like to report your Execution client statistics to https://ethstats.net/, enter the login you want to use here, in the form `secret@host:port`.",
 			Type:                 config.ParameterType_String,
 			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			Regex:                "^[^@\\s]+@[^@:\\s]+(:[0-9]+)?$",
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"ETHSTATS_LOGIN"},
 			CanBeBlank:           true,
 			OverwriteOnUpgrade:   false,
+			Secret:               true,
 		},
+
+		CpuLimit:    generateCpuLimitParameter("Execution client", config.ContainerID_Eth1, "EC_CPU_LIMIT"),
+		MemoryLimit: generateMemoryLimitParameter("Execution client", config.ContainerID_Eth1, "EC_MEMORY_LIMIT"),
 	}
 }
 
@@ -144,8 +160,11 @@ func (cfg *ExecutionCommonConfig) GetParameters() []*config.Parameter {
 		&cfg.EnginePort,
 		&cfg.OpenRpcPorts,
 		&cfg.P2pPort,
+		&cfg.Bootnodes,
 		&cfg.EthstatsLabel,
 		&cfg.EthstatsLogin,
+		&cfg.CpuLimit,
+		&cfg.MemoryLimit,
 	}
 }
 
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -17,6 +19,9 @@ type ExporterConfig struct {
 	// Toggle for enabling access to the root filesystem (for multiple disk usage metrics)
 	RootFs config.Parameter `yaml:"rootFs,omitempty"`
 
+	// Confirmation that the user understands the risks of root filesystem access
+	RootFsAcknowledged config.Parameter `yaml:"rootFsAcknowledged,omitempty"`
+
 	// The Docker Hub tag for Prometheus
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -41,6 +46,18 @@ func NewExporterConfig(cfg *RocketPoolConfig) *ExporterConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		RootFsAcknowledged: config.Parameter{
+			ID:                   "rootFsAcknowledged",
+			Name:                 "I Understand the Root Filesystem Risks",
+			Description:          "Root filesystem access lets the Node Exporter read your entire filesystem, including your node wallet and validator keys, so a vulnerability in it could expose them.\n\nYou must check this box to confirm you understand this before Allow Root Filesystem Access can be enabled.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Exporter},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		ContainerTag: config.Parameter{
 			ID:                   "containerTag",
 			Name:                 "Exporter Container Tag",
@@ -71,11 +88,21 @@ func NewExporterConfig(cfg *RocketPoolConfig) *ExporterConfig {
 func (cfg *ExporterConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.RootFs,
+		&cfg.RootFsAcknowledged,
 		&cfg.ContainerTag,
 		&cfg.AdditionalFlags,
 	}
 }
 
+// Checks that root filesystem access has been acknowledged if it's enabled; if it hasn't, returns a list of errors
+func (cfg *ExporterConfig) validateRootFs() []string {
+	errors := []string{}
+	if cfg.RootFs.Value == true && cfg.RootFsAcknowledged.Value != true {
+		errors = append(errors, fmt.Sprintf("[%s - %s] is enabled, but you haven't confirmed that you understand its risks. Please enable [%s] as well, or disable root filesystem access.", cfg.Title, cfg.RootFs.Name, cfg.RootFsAcknowledged.Name))
+	}
+	return errors
+}
+
 // The the title for the config
 func (cfg *ExporterConfig) GetConfigTitle() string {
 	return cfg.Title
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRootFsAcknowledgement(t *testing.T) {
+	tests := []struct {
+		name         string
+		rootFs       bool
+		acknowledged bool
+		valid        bool
+		mounted      bool
+	}{
+		{"disabled", false, false, true, false},
+		{"acknowledged without access", false, true, true, false},
+		{"enabled without acknowledgement", true, false, false, false},
+		{"enabled and acknowledged", true, true, true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.EnableMetrics.Value = true
+			cfg.Exporter.RootFs.Value = test.rootFs
+			cfg.Exporter.RootFsAcknowledged.Value = test.acknowledged
+
+			found := false
+			for _, err := range cfg.Validate() {
+				if strings.Contains(err, cfg.Exporter.RootFsAcknowledged.Name) {
+					found = true
+				}
+			}
+			if test.valid && found {
+				t.Error("expected no root filesystem acknowledgement error")
+			}
+			if !test.valid && !found {
+				t.Error("expected a root filesystem acknowledgement error")
+			}
+
+			_, mounted := cfg.GenerateEnvironmentVariables()["EXPORTER_ROOTFS_VOLUME"]
+			if mounted != test.mounted {
+				t.Errorf("expected the root filesystem to be mounted: %t, got %t", test.mounted, mounted)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/config"
 )
 
+// The URL schemes allowed for external HTTP and websocket endpoints
+var httpUrlSchemes = []string{"http", "https"}
+var wsUrlSchemes = []string{"ws", "wss"}
+
 // Configuration for external Execution clients
 type ExternalExecutionConfig struct {
 	Title string `yaml:"-"`
@@ -89,6 +93,7 @@ func NewExternalExecutionConfig(cfg *RocketPoolConfig) *ExternalExecutionConfig
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Eth2, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"EC_HTTP_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -101,6 +106,7 @@ func NewExternalExecutionConfig(cfg *RocketPoolConfig) *ExternalExecutionConfig
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Eth2, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"EC_WS_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           wsUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 	}
@@ -120,6 +126,7 @@ func NewExternalLighthouseConfig(cfg *RocketPoolConfig) *ExternalLighthouseConfi
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -192,6 +199,7 @@ func NewExternalPrysmConfig(cfg *RocketPoolConfig) *ExternalPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -204,6 +212,7 @@ func NewExternalPrysmConfig(cfg *RocketPoolConfig) *ExternalPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"CC_RPC_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -276,6 +285,7 @@ func NewExternalTekuConfig(cfg *RocketPoolConfig) *ExternalTekuConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -409,6 +419,15 @@ func (cfg *ExternalExecutionConfig) GetConfigTitle() string {
 	return cfg.Title
 }
 
+// Get the URL of the external Execution client's websocket endpoint, checking that it's a valid ws:// or wss:// URL
+func (cfg *ExternalExecutionConfig) GetWsUrl() (string, error) {
+	err := cfg.WsUrl.Validate(cfg.WsUrl.Value)
+	if err != nil {
+		return "", err
+	}
+	return cfg.WsUrl.Value.(string), nil
+}
+
 // The the title for the config
 func (cfg *ExternalLighthouseConfig) GetConfigTitle() string {
 	return cfg.Title
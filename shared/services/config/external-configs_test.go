@@ -0,0 +1,34 @@
+package config
+
+import "testing"
+
+func TestExternalExecutionGetWsUrl(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{"ws://192.168.1.10:8546", true},
+		{"wss://eth1.example.com", true},
+		{"http://192.168.1.10:8545", false},
+		{"https://eth1.example.com", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.url, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ExternalExecution.WsUrl.Value = test.url
+			url, err := cfg.ExternalExecution.GetWsUrl()
+			if test.valid {
+				if err != nil {
+					t.Fatalf("expected %s to be valid, got error: %s", test.url, err.Error())
+				}
+				if url != test.url {
+					t.Errorf("expected %s, got %s", test.url, url)
+				}
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %s to be rejected", test.url)
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ func NewFallbackNormalConfig(cfg *RocketPoolConfig) *FallbackNormalConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_EC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -55,6 +56,7 @@ func NewFallbackNormalConfig(cfg *RocketPoolConfig) *FallbackNormalConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 	}
@@ -74,6 +76,7 @@ func NewFallbackPrysmConfig(cfg *RocketPoolConfig) *FallbackPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_EC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -86,6 +89,7 @@ func NewFallbackPrysmConfig(cfg *RocketPoolConfig) *FallbackPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -98,6 +102,7 @@ func NewFallbackPrysmConfig(cfg *RocketPoolConfig) *FallbackPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"FALLBACK_CC_RPC_ENDPOINT"},
 			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 	}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Configuration for the fallback Validator client
+type FallbackValidatorConfig struct {
+	Title string `yaml:"-"`
+
+	// The client to run as the fallback Validator client
+	Client config.Parameter `yaml:"client,omitempty"`
+
+	// The URL of the Beacon Node HTTP endpoint the fallback Validator client will connect to
+	ApiUrl config.Parameter `yaml:"apiUrl,omitempty"`
+}
+
+// Generates a new FallbackValidatorConfig configuration
+func NewFallbackValidatorConfig(cfg *RocketPoolConfig) *FallbackValidatorConfig {
+	return &FallbackValidatorConfig{
+		Title: "Fallback Validator Client Settings",
+
+		Client: config.Parameter{
+			ID:                   "client",
+			Name:                 "Validator Client",
+			Description:          "Select which client to run as your fallback Validator client. Using a different client than your primary Validator client protects you from bugs that only affect one implementation.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.ConsensusClient_Lighthouse},
+			AffectsContainers:    []config.ContainerID{},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Lighthouse",
+				Description: "Use Lighthouse's Validator client as your fallback.",
+				Value:       config.ConsensusClient_Lighthouse,
+			}, {
+				Name:        "Prysm",
+				Description: "Use Prysm's Validator client as your fallback.",
+				Value:       config.ConsensusClient_Prysm,
+			}, {
+				Name:        "Teku",
+				Description: "Use Teku's Validator client as your fallback.",
+				Value:       config.ConsensusClient_Teku,
+			}},
+		},
+
+		ApiUrl: config.Parameter{
+			ID:                   "apiUrl",
+			Name:                 "Beacon Node URL",
+			Description:          "The URL of the HTTP Beacon API endpoint that your fallback Validator client will connect to. This should be a different Beacon Node than your primary one.\n\nNOTE: If you are running it on the same machine as the Smartnode, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			UrlSchemes:           httpUrlSchemes,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the config.Parameters for this config
+func (cfg *FallbackValidatorConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.Client,
+		&cfg.ApiUrl,
+	}
+}
+
+// The the title for the config
+func (config *FallbackValidatorConfig) GetConfigTitle() string {
+	return config.Title
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Creates a config with the fallback Validator client enabled and set up properly
+func newFallbackValidatorTestConfig() *RocketPoolConfig {
+	cfg := newTestConfig()
+	cfg.ConsensusClient.Value = config.ConsensusClient_Lighthouse
+	cfg.ConsensusCommon.DoppelgangerDetection.Value = true
+	cfg.UseFallbackValidator.Value = true
+	cfg.FallbackValidator.Client.Value = config.ConsensusClient_Teku
+	cfg.FallbackValidator.ApiUrl.Value = "http://192.168.1.20:5052"
+	return cfg
+}
+
+func TestFallbackValidatorEnabledContainers(t *testing.T) {
+	cfg := newTestConfig()
+	if cfg.UseFallbackValidator.Value != false {
+		t.Errorf("expected the fallback Validator client to be disabled by default")
+	}
+	expected := cfg.EnabledContainers()
+
+	// The fallback Validator client isn't deployed yet, so enabling it mustn't report a container or environment variables for it
+	cfg = newFallbackValidatorTestConfig()
+	containers := cfg.EnabledContainers()
+	if len(containers) != len(expected) {
+		t.Fatalf("expected containers %v with the fallback Validator client enabled, got %v", expected, containers)
+	}
+	for i, container := range containers {
+		if container != expected[i] {
+			t.Errorf("expected containers %v with the fallback Validator client enabled, got %v", expected, containers)
+			break
+		}
+	}
+	for name := range cfg.GenerateEnvironmentVariables() {
+		if strings.HasPrefix(name, "FALLBACK_VC_") {
+			t.Errorf("expected no fallback Validator client environment variables, got %s", name)
+		}
+	}
+}
+
+func TestFallbackValidatorValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(cfg *RocketPoolConfig)
+		errors []string
+	}{
+		{
+			name: "disabled",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.UseFallbackValidator.Value = false
+				cfg.ConsensusCommon.DoppelgangerDetection.Value = false
+			},
+			errors: []string{},
+		},
+		{
+			name:   "enabled with doppelganger protection",
+			setup:  func(cfg *RocketPoolConfig) {},
+			errors: []string{},
+		},
+		{
+			name:   "enabled without doppelganger protection",
+			setup:  func(cfg *RocketPoolConfig) { cfg.ConsensusCommon.DoppelgangerDetection.Value = false },
+			errors: []string{"Doppelganger Protection"},
+		},
+		{
+			name:   "enabled with a client that doesn't support doppelganger protection",
+			setup:  func(cfg *RocketPoolConfig) { cfg.ConsensusClient.Value = config.ConsensusClient_Teku },
+			errors: []string{"Doppelganger Protection"},
+		},
+		{
+			name:   "enabled without a Beacon Node URL",
+			setup:  func(cfg *RocketPoolConfig) { cfg.FallbackValidator.ApiUrl.Value = "" },
+			errors: []string{"Beacon Node URL"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newFallbackValidatorTestConfig()
+			test.setup(cfg)
+
+			errors := []string{}
+			for _, err := range cfg.Validate() {
+				if strings.Contains(err, "fallback Validator client") {
+					errors = append(errors, err)
+				}
+			}
+			if len(errors) != len(test.errors) {
+				t.Fatalf("expected %d fallback Validator client errors, got %v", len(test.errors), errors)
+			}
+			for i, expected := range test.errors {
+				if !strings.Contains(errors[i], expected) {
+					t.Errorf("expected the error to mention %s, got: %s", expected, errors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFallbackValidatorSlashingWarning(t *testing.T) {
+	cfg := newTestConfig()
+	if warnings := cfg.CheckFallbackValidatorRisks(); len(warnings) != 0 {
+		t.Errorf("expected no warnings with the fallback Validator client disabled, got %v", warnings)
+	}
+
+	cfg = newFallbackValidatorTestConfig()
+	warnings := cfg.CheckFallbackValidatorRisks()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "slashed") {
+		t.Fatalf("expected a single slashing warning, got %v", warnings)
+	}
+	if !strings.Contains(warnings[0], "does not deploy it") {
+		t.Errorf("expected the warning to say the fallback Validator client isn't deployed, got: %s", warnings[0])
+	}
+	found := false
+	for _, warning := range cfg.GetWarnings() {
+		if warning == warnings[0] {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected the slashing warning to be included in the config's warnings")
+	}
+
+	cfg.FallbackValidator.Client.Value = config.ConsensusClient_Lighthouse
+	warnings = cfg.CheckFallbackValidatorRisks()
+	if len(warnings) != 2 || !strings.Contains(warnings[1], "same client") {
+		t.Errorf("expected a warning about using the same client for both Validator clients, got %v", warnings)
+	}
+}
@@ -3,15 +3,18 @@ package config
 import (
 	"runtime"
 
-	"github.com/pbnjay/memory"
 	"github.com/rocket-pool/smartnode/shared/types/config"
 )
 
 // Constants
 const (
 	gethTag              string = "ethereum/client-go:v1.10.26"
+	gethTagBeta          string = "ethereum/client-go:stable"
+	gethTagNightly       string = "ethereum/client-go:latest"
 	gethEventLogInterval int    = 25000
 	gethStopSignal       string = "SIGTERM"
+	gethMinCache         uint64 = 128
+	gethMinPeers         uint16 = 1
 )
 
 // Configuration for Geth
@@ -33,6 +36,12 @@ type GethConfig struct {
 	// Max number of P2P peers to connect to
 	MaxPeers config.Parameter `yaml:"maxPeers,omitempty"`
 
+	// Toggle for exporting Geth's expensive (verbose) metrics
+	EnableExpensiveMetrics config.Parameter `yaml:"enableExpensiveMetrics,omitempty"`
+
+	// The log level for Geth
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
 	// The Docker Hub tag for Geth
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -52,6 +61,7 @@ func NewGethConfig(cfg *RocketPoolConfig) *GethConfig {
 			config.ConsensusClient_Nimbus,
 			config.ConsensusClient_Prysm,
 			config.ConsensusClient_Teku,
+			config.ConsensusClient_Lodestar,
 		},
 
 		EventLogInterval: gethEventLogInterval,
@@ -61,7 +71,8 @@ func NewGethConfig(cfg *RocketPoolConfig) *GethConfig {
 			Name:                 "Cache Size",
 			Description:          "The amount of RAM (in MB) you want Geth's cache to use. Larger values mean your disk space usage will increase slower, and you will have to prune less frequently. The default is based on how much total RAM your system has but you can adjust it manually.",
 			Type:                 config.ParameterType_Uint,
-			Default:              map[config.Network]interface{}{config.Network_All: calculateGethCache()},
+			Default:              map[config.Network]interface{}{config.Network_All: calculateGethCache(getTotalMemoryGB())},
+			MinValue:             gethMinCache,
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"EC_CACHE_SIZE"},
 			CanBeBlank:           false,
@@ -74,12 +85,27 @@ func NewGethConfig(cfg *RocketPoolConfig) *GethConfig {
 			Description:          "The maximum number of peers Geth should connect to. This can be lowered to improve performance on low-power systems or constrained config.Networks. We recommend keeping it at 12 or higher.",
 			Type:                 config.ParameterType_Uint16,
 			Default:              map[config.Network]interface{}{config.Network_All: calculateGethPeers()},
+			MinValue:             gethMinPeers,
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"EC_MAX_PEERS"},
 			CanBeBlank:           false,
 			OverwriteOnUpgrade:   false,
 		},
 
+		EnableExpensiveMetrics: config.Parameter{
+			ID:                   "enableExpensiveMetrics",
+			Name:                 "Enable Expensive Metrics",
+			Description:          "Enable this to have Geth export its verbose metrics (such as detailed database and state access timings) in addition to its normal ones. These are useful for debugging performance problems, but they are expensive for Geth to collect so they are disabled by default.\n\nThis only has an effect if the Smartnode's metrics are enabled.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		LogLevel: generateLogLevelParameter("Geth", []config.ContainerID{config.ContainerID_Eth1}),
+
 		ContainerTag: config.Parameter{
 			ID:                   "containerTag",
 			Name:                 "Container Tag",
@@ -107,12 +133,9 @@ func NewGethConfig(cfg *RocketPoolConfig) *GethConfig {
 }
 
 // Calculate the recommended size for Geth's cache based on the amount of system RAM
-func calculateGethCache() uint64 {
-	totalMemoryGB := memory.TotalMemory() / 1024 / 1024 / 1024
-
-	if totalMemoryGB == 0 {
-		return 0
-	} else if totalMemoryGB < 9 {
+func calculateGethCache(totalMemoryGB uint64) uint64 {
+	if totalMemoryGB < 9 {
+		// This includes 0, for systems where the total memory can't be read
 		return 256
 	} else if totalMemoryGB < 13 {
 		return 2048
@@ -140,6 +163,8 @@ func (cfg *GethConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.CacheSize,
 		&cfg.MaxPeers,
+		&cfg.EnableExpensiveMetrics,
+		&cfg.LogLevel,
 		&cfg.ContainerTag,
 		&cfg.AdditionalFlags,
 	}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestGethExpensiveMetrics(t *testing.T) {
+	tests := []struct {
+		name             string
+		metrics          bool
+		expensiveMetrics bool
+		flag             bool
+	}{
+		{"metrics disabled", false, false, false},
+		{"expensive metrics without metrics", false, true, false},
+		{"metrics without expensive metrics", true, false, false},
+		{"expensive metrics", true, true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+			cfg.EnableMetrics.Value = test.metrics
+			cfg.Geth.EnableExpensiveMetrics.Value = test.expensiveMetrics
+
+			flag, exists := cfg.GenerateEnvironmentVariables()["EC_METRICS_EXPENSIVE_FLAG"]
+			if test.flag && flag != "--metrics.expensive" {
+				t.Errorf("expected the expensive metrics flag, got [%s]", flag)
+			}
+			if !test.flag && exists {
+				t.Errorf("expected no expensive metrics flag, got [%s]", flag)
+			}
+		})
+	}
+}
+
+func TestGethMetricsScrapeTarget(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+	cfg.EcMetricsPort.Value = uint16(9205)
+
+	cfg.EnableMetrics.Value = false
+	if _, err := cfg.GeneratePrometheusConfig(); err == nil {
+		t.Error("expected no Prometheus config with metrics disabled")
+	}
+
+	cfg.EnableMetrics.Value = true
+	prometheusConfig, err := cfg.GeneratePrometheusConfig()
+	if err != nil {
+		t.Fatalf("error generating Prometheus config: %s", err.Error())
+	}
+	target := Eth1ContainerName + ":9205"
+	if !strings.Contains(string(prometheusConfig), target) {
+		t.Errorf("expected the Prometheus config to scrape %s, got:\n%s", target, prometheusConfig)
+	}
+
+	cfg.ExecutionClientMode.Value = config.Mode_External
+	prometheusConfig, err = cfg.GeneratePrometheusConfig()
+	if err != nil {
+		t.Fatalf("error generating Prometheus config: %s", err.Error())
+	}
+	if strings.Contains(string(prometheusConfig), target) {
+		t.Errorf("expected the Prometheus config not to scrape an external Execution client, got:\n%s", prometheusConfig)
+	}
+}
+
+func TestCalculateGethCache(t *testing.T) {
+	tests := []struct {
+		totalMemoryGB uint64
+		expected      uint64
+	}{
+		{0, 256},
+		{8, 256},
+		{12, 2048},
+		{16, 4096},
+		{24, 8192},
+		{32, 12288},
+		{64, 16384},
+	}
+
+	cfg := NewRocketPoolConfig("", false)
+	for _, test := range tests {
+		cache := calculateGethCache(test.totalMemoryGB)
+		if cache != test.expected {
+			t.Errorf("expected a cache of %d MB for %d GB of RAM, got %d", test.expected, test.totalMemoryGB, cache)
+		}
+		if err := cfg.Geth.CacheSize.Validate(cache); err != nil {
+			t.Errorf("expected the default cache of %d MB to be valid, got error: %s", cache, err.Error())
+		}
+	}
+
+	if err := cfg.Geth.CacheSize.Validate(uint64(0)); err == nil {
+		t.Error("expected a cache of 0 MB to be rejected")
+	}
+}
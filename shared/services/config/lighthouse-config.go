@@ -6,11 +6,15 @@ import (
 )
 
 const (
-	lighthouseTagPortableTest string = "sigp/lighthouse:v3.3.0"
-	lighthouseTagPortableProd string = "sigp/lighthouse:v3.3.0"
-	lighthouseTagModernTest   string = "sigp/lighthouse:v3.3.0-modern"
-	lighthouseTagModernProd   string = "sigp/lighthouse:v3.3.0-modern"
-	defaultLhMaxPeers         uint16 = 80
+	lighthouseTagPortableTest    string = "sigp/lighthouse:v3.3.0"
+	lighthouseTagPortableProd    string = "sigp/lighthouse:v3.3.0"
+	lighthouseTagModernTest      string = "sigp/lighthouse:v3.3.0-modern"
+	lighthouseTagModernProd      string = "sigp/lighthouse:v3.3.0-modern"
+	lighthouseTagPortableBeta    string = "sigp/lighthouse:latest"
+	lighthouseTagModernBeta      string = "sigp/lighthouse:latest-modern"
+	lighthouseTagPortableNightly string = "sigp/lighthouse:latest-unstable"
+	lighthouseTagModernNightly   string = "sigp/lighthouse:latest-unstable-modern"
+	defaultLhMaxPeers            uint16 = 80
 )
 
 // Configuration for Lighthouse
@@ -23,6 +27,9 @@ type LighthouseConfig struct {
 	// Common parameters that Lighthouse doesn't support and should be hidden
 	UnsupportedCommonParams []string `yaml:"-"`
 
+	// The log level for Lighthouse
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
 	// The Docker Hub tag for Lighthouse
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -50,6 +57,8 @@ func NewLighthouseConfig(cfg *RocketPoolConfig) *LighthouseConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		LogLevel: generateLogLevelParameter("Lighthouse", []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator}),
+
 		ContainerTag: config.Parameter{
 			ID:          "containerTag",
 			Name:        "Container Tag",
@@ -96,6 +105,7 @@ func NewLighthouseConfig(cfg *RocketPoolConfig) *LighthouseConfig {
 func (cfg *LighthouseConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.MaxPeers,
+		&cfg.LogLevel,
 		&cfg.ContainerTag,
 		&cfg.AdditionalBnFlags,
 		&cfg.AdditionalVcFlags,
@@ -139,3 +149,21 @@ func getLighthouseTagTest() string {
 	}
 	return lighthouseTagModernTest
 }
+
+// Get the appropriate LH tag for the beta update channel
+func getLighthouseTagBeta() string {
+	missingFeatures := sys.GetMissingModernCpuFeatures()
+	if len(missingFeatures) > 0 {
+		return lighthouseTagPortableBeta
+	}
+	return lighthouseTagModernBeta
+}
+
+// Get the appropriate LH tag for the nightly update channel
+func getLighthouseTagNightly() string {
+	missingFeatures := sys.GetMissingModernCpuFeatures()
+	if len(missingFeatures) > 0 {
+		return lighthouseTagPortableNightly
+	}
+	return lighthouseTagModernNightly
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Checks the parameter definitions themselves (rather than the user's settings) for mistakes; if any are found, returns a
+// list of issues describing them
+func (cfg *RocketPoolConfig) Lint() []config.Issue {
+	issues := []config.Issue{}
+	defaultTrackingParams := cfg.getDefaultTrackingParameters()
+	unlistedParams := cfg.getUnlistedParameters()
+
+	lintParams := func(sectionName string, section interface{}, params []*config.Parameter) {
+		// Every parameter field should be returned by GetParameters, or it won't be saved, shown in the UI, or validated
+		listedParams := map[*config.Parameter]bool{}
+		for _, param := range params {
+			listedParams[param] = true
+		}
+		for _, param := range getParameterFields(section) {
+			if !listedParams[param] && !unlistedParams[param] {
+				issues = append(issues, config.Issue{
+					Severity: config.IssueSeverity_Error,
+					Message:  fmt.Sprintf("[%s.%s] is a parameter field but it isn't returned by GetParameters().", sectionName, param.ID),
+				})
+			}
+		}
+
+		for _, param := range params {
+			// Parameters that are overwritten on upgrade will lose any changes the user made to them, so only container tags and
+			// settings that deliberately track the latest defaults should be flagged that way
+			if param.OverwriteOnUpgrade && !isContainerTagParameter(param) && !defaultTrackingParams[param] {
+				issues = append(issues, config.Issue{
+					Severity: config.IssueSeverity_Warning,
+					Message:  fmt.Sprintf("[%s.%s] is overwritten on upgrade, but it isn't a container tag or a default-tracking setting so the user's changes to it will be lost.", sectionName, param.ID),
+				})
+			}
+		}
+	}
+
+	lintParams(rootConfigName, cfg, cfg.GetParameters())
+	subconfigs := cfg.GetSubconfigs()
+	names := make([]string, 0, len(subconfigs))
+	for name := range subconfigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		lintParams(name, subconfigs[name], subconfigs[name].GetParameters())
+	}
+
+	return issues
+}
+
+// Get the parameters that are intentionally reset to their defaults on every upgrade, even though they aren't container tags
+func (cfg *RocketPoolConfig) getDefaultTrackingParameters() map[*config.Parameter]bool {
+	return map[*config.Parameter]bool{
+		&cfg.EnableMevBoost: true,
+	}
+}
+
+// Get the parameter fields that are intentionally left out of GetParameters()
+func (cfg *RocketPoolConfig) getUnlistedParameters() map[*config.Parameter]bool {
+	return map[*config.Parameter]bool{
+		// The watchtower state is always kept in the data folder now (see GetWatchtowerStatePath), so this isn't configurable
+		&cfg.Smartnode.WatchtowerStatePath: true,
+	}
+}
+
+// Get all of the parameter fields of a config section (which must be a pointer to a struct) by reflection, including ones
+// that its GetParameters() function may have missed
+func getParameterFields(section interface{}) []*config.Parameter {
+	params := []*config.Parameter{}
+	value := reflect.ValueOf(section)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return params
+	}
+
+	value = value.Elem()
+	paramType := reflect.TypeOf(config.Parameter{})
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Type() == paramType && field.CanAddr() && field.CanInterface() {
+			params = append(params, field.Addr().Interface().(*config.Parameter))
+		}
+	}
+	return params
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestLintDefaultConfig(t *testing.T) {
+	if issues := NewRocketPoolConfig("/tmp/rocketpool", false).Lint(); len(issues) != 0 {
+		t.Errorf("expected the parameter definitions to pass linting, got %v", issues)
+	}
+}
+
+func TestLintOverwriteOnUpgrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		param    func(cfg *RocketPoolConfig) *config.Parameter
+		expected string
+	}{
+		{"misflagged URL", func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ExternalExecution.HttpUrl }, "externalExecution.httpUrl"},
+		{"misflagged secret", func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.BitflyNodeMetrics.Secret }, "bitflyNodeMetrics.bitflySecret"},
+		{"container tag", func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.Geth.ContainerTag }, ""},
+		{"default-tracking setting", func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.EnableMevBoost }, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := NewRocketPoolConfig("/tmp/rocketpool", false)
+			test.param(cfg).OverwriteOnUpgrade = true
+
+			issues := cfg.Lint()
+			if test.expected == "" {
+				if len(issues) != 0 {
+					t.Errorf("expected no issues, got %v", issues)
+				}
+				return
+			}
+			if len(issues) != 1 {
+				t.Fatalf("expected 1 issue, got %v", issues)
+			}
+			if issues[0].Severity != config.IssueSeverity_Warning {
+				t.Errorf("expected a warning, got severity %v", issues[0].Severity)
+			}
+			if !strings.Contains(issues[0].Message, test.expected) {
+				t.Errorf("expected the warning to mention %s, got: %s", test.expected, issues[0].Message)
+			}
+		})
+	}
+}
+
+func TestGetParameterFields(t *testing.T) {
+	section := &struct {
+		Title    string
+		First    config.Parameter
+		Second   config.Parameter
+		Pointer  *config.Parameter
+		unlisted config.Parameter
+	}{}
+
+	fields := getParameterFields(section)
+	if len(fields) != 2 || fields[0] != &section.First || fields[1] != &section.Second {
+		t.Errorf("expected the two exported parameter fields, got %v", fields)
+	}
+	if fields := getParameterFields(*section); len(fields) != 0 {
+		t.Errorf("expected no fields for a non-pointer section, got %v", fields)
+	}
+}
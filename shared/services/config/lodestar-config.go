@@ -0,0 +1,128 @@
+package config
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+const (
+	lodestarTagTest         string = "chainsafe/lodestar:v1.2.1"
+	lodestarTagProd         string = "chainsafe/lodestar:v1.2.1"
+	lodestarTagBeta         string = "chainsafe/lodestar:latest"
+	lodestarTagNightly      string = "chainsafe/lodestar:next"
+	defaultLodestarMaxPeers uint16 = 50
+)
+
+// Configuration for Lodestar
+type LodestarConfig struct {
+	Title string `yaml:"-"`
+
+	// The max number of P2P peers to connect to
+	MaxPeers config.Parameter `yaml:"maxPeers,omitempty"`
+
+	// Common parameters that Lodestar doesn't support and should be hidden
+	UnsupportedCommonParams []string `yaml:"-"`
+
+	// The log level for Lodestar
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
+	// The Docker Hub tag for Lodestar
+	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
+
+	// Custom command line flags for the BN
+	AdditionalBnFlags config.Parameter `yaml:"additionalBnFlags,omitempty"`
+
+	// Custom command line flags for the VC
+	AdditionalVcFlags config.Parameter `yaml:"additionalVcFlags,omitempty"`
+}
+
+// Generates a new Lodestar configuration
+func NewLodestarConfig(cfg *RocketPoolConfig) *LodestarConfig {
+	return &LodestarConfig{
+		Title: "Lodestar Settings",
+
+		MaxPeers: config.Parameter{
+			ID:                   "maxPeers",
+			Name:                 "Max Peers",
+			Description:          "The maximum number of peers your client should try to maintain. You can try lowering this if you have a low-resource system or a constrained network.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultLodestarMaxPeers},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
+			EnvironmentVariables: []string{"BN_MAX_PEERS"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		LogLevel: generateLogLevelParameter("Lodestar", []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator}),
+
+		ContainerTag: config.Parameter{
+			ID:          "containerTag",
+			Name:        "Container Tag",
+			Description: "The tag name of the Lodestar container you want to use from Docker Hub.",
+			Type:        config.ParameterType_String,
+			Default: map[config.Network]interface{}{
+				config.Network_Mainnet: lodestarTagProd,
+				config.Network_Prater:  lodestarTagTest,
+				config.Network_Devnet:  lodestarTagTest,
+			},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator},
+			EnvironmentVariables: []string{"BN_CONTAINER_TAG", "VC_CONTAINER_TAG"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   true,
+		},
+
+		AdditionalBnFlags: config.Parameter{
+			ID:                   "additionalBnFlags",
+			Name:                 "Additional Beacon Client Flags",
+			Description:          "Additional custom command line flags you want to pass Lodestar's Beacon Client, to take advantage of other settings that the Smartnode's configuration doesn't cover.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
+			EnvironmentVariables: []string{"BN_ADDITIONAL_FLAGS"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AdditionalVcFlags: config.Parameter{
+			ID:                   "additionalVcFlags",
+			Name:                 "Additional Validator Client Flags",
+			Description:          "Additional custom command line flags you want to pass Lodestar's Validator Client, to take advantage of other settings that the Smartnode's configuration doesn't cover.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator},
+			EnvironmentVariables: []string{"VC_ADDITIONAL_FLAGS"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *LodestarConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.MaxPeers,
+		&cfg.LogLevel,
+		&cfg.ContainerTag,
+		&cfg.AdditionalBnFlags,
+		&cfg.AdditionalVcFlags,
+	}
+}
+
+// Get the common params that this client doesn't support
+func (cfg *LodestarConfig) GetUnsupportedCommonParams() []string {
+	return cfg.UnsupportedCommonParams
+}
+
+// Get the Docker container name of the validator client
+func (cfg *LodestarConfig) GetValidatorImage() string {
+	return cfg.ContainerTag.Value.(string)
+}
+
+// Get the name of the client
+func (cfg *LodestarConfig) GetName() string {
+	return "Lodestar"
+}
+
+// The the title for the config
+func (cfg *LodestarConfig) GetConfigTitle() string {
+	return cfg.Title
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Geth's numeric verbosity levels for each log level
+var gethVerbosityLevels = map[config.LogLevel]string{
+	config.LogLevel_Error: "1",
+	config.LogLevel_Warn:  "2",
+	config.LogLevel_Info:  "3",
+	config.LogLevel_Debug: "4",
+	config.LogLevel_Trace: "5",
+}
+
+// Generates a parameter for setting the verbosity of a client's logs
+func generateLogLevelParameter(clientName string, containers []config.ContainerID) config.Parameter {
+	return config.Parameter{
+		ID:                   "logLevel",
+		Name:                 "Log Level",
+		Description:          fmt.Sprintf("Select how verbose %s's logs should be. Higher levels are useful for debugging problems, but they produce much more output.", clientName),
+		Type:                 config.ParameterType_Choice,
+		Default:              map[config.Network]interface{}{config.Network_All: config.LogLevel_Info},
+		AffectsContainers:    containers,
+		EnvironmentVariables: []string{},
+		CanBeBlank:           false,
+		OverwriteOnUpgrade:   false,
+		Options: []config.ParameterOption{{
+			Name:        "Error",
+			Description: "Only log errors.",
+			Value:       config.LogLevel_Error,
+		}, {
+			Name:        "Warning",
+			Description: "Log errors and warnings.",
+			Value:       config.LogLevel_Warn,
+		}, {
+			Name:        "Info",
+			Description: "Log errors, warnings, and general information about what the client is doing.",
+			Value:       config.LogLevel_Info,
+		}, {
+			Name:        "Debug",
+			Description: "Also log detailed information that's useful for debugging.",
+			Value:       config.LogLevel_Debug,
+		}, {
+			Name:        "Trace",
+			Description: "Log everything the client does. This produces a very large amount of output.",
+			Value:       config.LogLevel_Trace,
+		}},
+	}
+}
+
+// Get the command line flag that sets an Execution client's log level
+func GetExecutionClientLogLevelFlag(client config.ExecutionClient, level config.LogLevel) string {
+	switch client {
+	case config.ExecutionClient_Geth:
+		return fmt.Sprintf("--verbosity=%s", gethVerbosityLevels[level])
+	case config.ExecutionClient_Nethermind:
+		return fmt.Sprintf("--log=%s", strings.ToUpper(string(level)))
+	case config.ExecutionClient_Besu:
+		return fmt.Sprintf("--logging=%s", strings.ToUpper(string(level)))
+	case config.ExecutionClient_Erigon:
+		return fmt.Sprintf("--log.console.verbosity=%s", level)
+	default:
+		return ""
+	}
+}
+
+// Get the command line flag that sets a Consensus client's log level
+func GetConsensusClientLogLevelFlag(client config.ConsensusClient, level config.LogLevel) string {
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		return fmt.Sprintf("--debug-level=%s", level)
+	case config.ConsensusClient_Nimbus:
+		return fmt.Sprintf("--log-level=%s", strings.ToUpper(string(level)))
+	case config.ConsensusClient_Prysm:
+		return fmt.Sprintf("--verbosity=%s", level)
+	case config.ConsensusClient_Teku:
+		return fmt.Sprintf("--logging=%s", strings.ToUpper(string(level)))
+	case config.ConsensusClient_Lodestar:
+		return fmt.Sprintf("--logLevel=%s", level)
+	default:
+		return ""
+	}
+}
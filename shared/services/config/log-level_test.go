@@ -0,0 +1,67 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestExecutionClientLogLevelFlag(t *testing.T) {
+	tests := []struct {
+		client   config.ExecutionClient
+		level    config.LogLevel
+		expected string
+	}{
+		{config.ExecutionClient_Geth, config.LogLevel_Debug, "--verbosity=4"},
+		{config.ExecutionClient_Geth, config.LogLevel_Error, "--verbosity=1"},
+		{config.ExecutionClient_Geth, config.LogLevel_Trace, "--verbosity=5"},
+		{config.ExecutionClient_Nethermind, config.LogLevel_Debug, "--log=DEBUG"},
+		{config.ExecutionClient_Besu, config.LogLevel_Warn, "--logging=WARN"},
+		{config.ExecutionClient_Erigon, config.LogLevel_Info, "--log.console.verbosity=info"},
+	}
+
+	for _, test := range tests {
+		flag := GetExecutionClientLogLevelFlag(test.client, test.level)
+		if flag != test.expected {
+			t.Errorf("expected %s's %s level to be %s, got %s", test.client, test.level, test.expected, flag)
+		}
+	}
+}
+
+func TestConsensusClientLogLevelFlag(t *testing.T) {
+	tests := []struct {
+		client   config.ConsensusClient
+		level    config.LogLevel
+		expected string
+	}{
+		{config.ConsensusClient_Lighthouse, config.LogLevel_Debug, "--debug-level=debug"},
+		{config.ConsensusClient_Lighthouse, config.LogLevel_Warn, "--debug-level=warn"},
+		{config.ConsensusClient_Nimbus, config.LogLevel_Debug, "--log-level=DEBUG"},
+		{config.ConsensusClient_Prysm, config.LogLevel_Debug, "--verbosity=debug"},
+		{config.ConsensusClient_Teku, config.LogLevel_Debug, "--logging=DEBUG"},
+		{config.ConsensusClient_Lodestar, config.LogLevel_Debug, "--logLevel=debug"},
+	}
+
+	for _, test := range tests {
+		flag := GetConsensusClientLogLevelFlag(test.client, test.level)
+		if flag != test.expected {
+			t.Errorf("expected %s's %s level to be %s, got %s", test.client, test.level, test.expected, flag)
+		}
+	}
+}
+
+func TestLogLevelEnvironmentVariables(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+	cfg.ConsensusClient.Value = config.ConsensusClient_Lighthouse
+	cfg.Geth.LogLevel.Value = config.LogLevel_Debug
+	cfg.Lighthouse.LogLevel.Value = config.LogLevel_Debug
+
+	envVars := cfg.GenerateEnvironmentVariables()
+	if envVars["EC_LOG_LEVEL_FLAG"] != "--verbosity=4" {
+		t.Errorf("expected Geth's log level flag to be --verbosity=4, got %s", envVars["EC_LOG_LEVEL_FLAG"])
+	}
+	if envVars["CC_LOG_LEVEL_FLAG"] != "--debug-level=debug" {
+		t.Errorf("expected Lighthouse's log level flag to be --debug-level=debug, got %s", envVars["CC_LOG_LEVEL_FLAG"])
+	}
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Constants
+const (
+	logMaxSizeRegex       string = "^[1-9][0-9]*[kmg]$"
+	defaultLogMaxSize     string = "20m"
+	defaultLogMaxFiles    uint64 = 5
+	minLogMaxFiles        uint64 = 1
+	maxLogMaxFiles        uint64 = 100
+	LoggingFragmentEnvVar string = "DOCKER_LOGGING"
+)
+
+// The containers whose logs are rotated by the log rotation settings
+var logRotationContainers = []config.ContainerID{
+	config.ContainerID_Api,
+	config.ContainerID_Node,
+	config.ContainerID_Watchtower,
+	config.ContainerID_Eth1,
+	config.ContainerID_Eth2,
+	config.ContainerID_Validator,
+	config.ContainerID_Grafana,
+	config.ContainerID_Prometheus,
+	config.ContainerID_Exporter,
+	config.ContainerID_MevBoost,
+}
+
+// Generates a parameter for the largest size a container's log file can grow to before it's rotated
+func generateLogMaxSizeParameter() config.Parameter {
+	return config.Parameter{
+		ID:                   "logMaxSize",
+		Name:                 "Log Max Size",
+		Description:          "The largest size each container's log file can grow to before Docker starts a new one, as a number followed by a unit (k, m, or g) such as `20m`.",
+		Type:                 config.ParameterType_String,
+		Default:              map[config.Network]interface{}{config.Network_All: defaultLogMaxSize},
+		Regex:                logMaxSizeRegex,
+		AffectsContainers:    logRotationContainers,
+		EnvironmentVariables: []string{},
+		CanBeBlank:           false,
+		OverwriteOnUpgrade:   false,
+	}
+}
+
+// Generates a parameter for the number of log files Docker keeps for each container
+func generateLogMaxFilesParameter() config.Parameter {
+	return config.Parameter{
+		ID:                   "logMaxFiles",
+		Name:                 "Log Max Files",
+		Description:          fmt.Sprintf("The number of log files Docker keeps for each container. When a new one is started, the oldest one is deleted.\n\nMust be between %d and %d.", minLogMaxFiles, maxLogMaxFiles),
+		Type:                 config.ParameterType_Uint,
+		Default:              map[config.Network]interface{}{config.Network_All: defaultLogMaxFiles},
+		MinValue:             minLogMaxFiles,
+		MaxValue:             maxLogMaxFiles,
+		AffectsContainers:    logRotationContainers,
+		EnvironmentVariables: []string{},
+		CanBeBlank:           false,
+		OverwriteOnUpgrade:   false,
+	}
+}
+
+// Get the Docker Compose `logging` section for a container that rotates its logs with the given settings, formatted so it can
+// be inserted into a compose file with a single environment variable
+func GetLoggingFragment(maxSize string, maxFiles uint64) string {
+	return fmt.Sprintf("{\"driver\": \"json-file\", \"options\": {\"max-size\": \"%s\", \"max-file\": \"%d\"}}", maxSize, maxFiles)
+}
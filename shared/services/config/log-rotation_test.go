@@ -0,0 +1,62 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestLogRotationValidation(t *testing.T) {
+	cfg := newTestConfig()
+
+	sizeTests := []struct {
+		maxSize string
+		valid   bool
+	}{
+		{"20m", true},
+		{"512k", true},
+		{"1g", true},
+		{"0m", false},
+		{"20", false},
+		{"20mb", false},
+		{"20M", false},
+	}
+	for _, test := range sizeTests {
+		err := cfg.Smartnode.LogMaxSize.Validate(test.maxSize)
+		if test.valid && err != nil {
+			t.Errorf("expected a max size of [%s] to be valid, got error: %s", test.maxSize, err.Error())
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected a max size of [%s] to be rejected", test.maxSize)
+		}
+	}
+
+	fileTests := []struct {
+		maxFiles uint64
+		valid    bool
+	}{
+		{0, false},
+		{1, true},
+		{100, true},
+		{101, false},
+	}
+	for _, test := range fileTests {
+		err := cfg.Smartnode.LogMaxFiles.Validate(test.maxFiles)
+		if test.valid && err != nil {
+			t.Errorf("expected %d max files to be valid, got error: %s", test.maxFiles, err.Error())
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected %d max files to be rejected", test.maxFiles)
+		}
+	}
+}
+
+func TestLoggingFragment(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Smartnode.LogMaxSize.Value = "50m"
+	cfg.Smartnode.LogMaxFiles.Value = uint64(3)
+
+	expected := `{"driver": "json-file", "options": {"max-size": "50m", "max-file": "3"}}`
+	fragment := cfg.GenerateEnvironmentVariables()[LoggingFragmentEnvVar]
+	if fragment != expected {
+		t.Errorf("expected %s to be [%s], got [%s]", LoggingFragmentEnvVar, expected, fragment)
+	}
+}
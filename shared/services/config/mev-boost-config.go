@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/rocket-pool/smartnode/shared/types/config"
@@ -21,6 +22,9 @@ const (
 	AllMevRelayDescription      string = "and allow for all types of MEV (including sandwich attacks)."
 )
 
+// The highest minimum bid MEV-Boost accepts, in ETH
+const maxMevBoostMinBid float64 = 1
+
 // Configuration for MEV-Boost
 type MevBoostConfig struct {
 	Title string `yaml:"-"`
@@ -67,6 +71,9 @@ type MevBoostConfig struct {
 	// Toggle for forwarding the HTTP port outside of Docker
 	OpenRpcPort config.Parameter `yaml:"openRpcPort,omitempty"`
 
+	// The minimum bid (in ETH) to accept from a relay
+	MinBid config.Parameter `yaml:"minBid,omitempty"`
+
 	// The Docker Hub tag for MEV-Boost
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -178,6 +185,20 @@ func NewMevBoostConfig(cfg *RocketPoolConfig) *MevBoostConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		MinBid: config.Parameter{
+			ID:                   "minBid",
+			Name:                 "Minimum Bid",
+			Description:          fmt.Sprintf("The minimum value (in ETH) a relay's block must pay you for MEV-Boost to use it. If none of the relays offer at least this much, your Consensus client will build the block locally instead.\n\nMust be between 0 and %v. Use 0 to accept any bid.", maxMevBoostMinBid),
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			MinValue:             float64(0),
+			MaxValue:             maxMevBoostMinBid,
+			AffectsContainers:    []config.ContainerID{config.ContainerID_MevBoost},
+			EnvironmentVariables: []string{"MEV_BOOST_MIN_BID"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		ContainerTag: config.Parameter{
 			ID:                   "containerTag",
 			Name:                 "Container Tag",
@@ -211,6 +232,7 @@ func NewMevBoostConfig(cfg *RocketPoolConfig) *MevBoostConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
 			EnvironmentVariables: []string{mevBoostUrlEnvVar},
 			CanBeBlank:           true,
+			UrlSchemes:           httpUrlSchemes,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -236,6 +258,7 @@ func (cfg *MevBoostConfig) GetParameters() []*config.Parameter {
 		&cfg.EdenRelay,
 		&cfg.Port,
 		&cfg.OpenRpcPort,
+		&cfg.MinBid,
 		&cfg.ContainerTag,
 		&cfg.AdditionalFlags,
 		&cfg.ExternalUrl,
@@ -565,3 +588,11 @@ func getMevBoostTag() string {
 	}
 	return mevBoostModernTag
 }
+
+// Get the command line flag that sets MEV-Boost's minimum bid, or an empty string if any bid should be accepted
+func GetMevBoostMinBidFlag(minBid float64) string {
+	if minBid <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("-min-bid=%s", strconv.FormatFloat(minBid, 'f', -1, 64))
+}
@@ -0,0 +1,237 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Get the errors from validating a config that are about MEV-Boost
+func getMevBoostErrors(cfg *RocketPoolConfig) []string {
+	errors := []string{}
+	for _, err := range cfg.Validate() {
+		if strings.Contains(strings.ToLower(err), "mev-boost") {
+			errors = append(errors, err)
+		}
+	}
+	return errors
+}
+
+func TestMevBoostRelayValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(cfg *RocketPoolConfig)
+		valid bool
+	}{
+		{
+			name: "disabled with no relays",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.EnableMevBoost.Value = false
+				cfg.MevBoost.SelectionMode.Value = config.MevSelectionMode_Relay
+			},
+			valid: true,
+		},
+		{
+			name:  "no relays selected",
+			setup: func(cfg *RocketPoolConfig) { cfg.MevBoost.SelectionMode.Value = config.MevSelectionMode_Relay },
+			valid: false,
+		},
+		{
+			name:  "no profiles selected",
+			setup: func(cfg *RocketPoolConfig) { cfg.MevBoost.SelectionMode.Value = config.MevSelectionMode_Profile },
+			valid: false,
+		},
+		{
+			name: "one relay selected",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.MevBoost.SelectionMode.Value = config.MevSelectionMode_Relay
+				cfg.MevBoost.FlashbotsRelay.Value = true
+			},
+			valid: true,
+		},
+		{
+			name: "one profile selected",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.MevBoost.SelectionMode.Value = config.MevSelectionMode_Profile
+				cfg.MevBoost.EnableRegulatedAllMev.Value = true
+			},
+			valid: true,
+		},
+		{
+			name: "external without a URL",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.MevBoost.Mode.Value = config.Mode_External
+				cfg.MevBoost.ExternalUrl.Value = ""
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.EnableMevBoost.Value = true
+			cfg.MevBoost.Mode.Value = config.Mode_Local
+			for _, param := range []*config.Parameter{
+				&cfg.MevBoost.EnableRegulatedAllMev,
+				&cfg.MevBoost.EnableRegulatedNoSandwich,
+				&cfg.MevBoost.EnableUnregulatedAllMev,
+				&cfg.MevBoost.EnableUnregulatedNoSandwich,
+				&cfg.MevBoost.FlashbotsRelay,
+				&cfg.MevBoost.BloxRouteEthicalRelay,
+				&cfg.MevBoost.BloxRouteMaxProfitRelay,
+				&cfg.MevBoost.BloxRouteRegulatedRelay,
+				&cfg.MevBoost.BlocknativeRelay,
+				&cfg.MevBoost.EdenRelay,
+			} {
+				param.Value = false
+			}
+			test.setup(cfg)
+
+			errors := getMevBoostErrors(cfg)
+			if test.valid && len(errors) > 0 {
+				t.Errorf("expected no MEV-Boost errors, got %v", errors)
+			}
+			if !test.valid && len(errors) == 0 {
+				t.Error("expected a MEV-Boost error")
+			}
+		})
+	}
+}
+
+func TestMevBoostConsensusClientSupport(t *testing.T) {
+	// Every Consensus client the Smartnode offers supports the builder API, so none of them should be rejected
+	for _, client := range []config.ConsensusClient{
+		config.ConsensusClient_Lighthouse,
+		config.ConsensusClient_Lodestar,
+		config.ConsensusClient_Nimbus,
+		config.ConsensusClient_Prysm,
+		config.ConsensusClient_Teku,
+	} {
+		cfg := newTestConfig()
+		cfg.EnableMevBoost.Value = true
+		cfg.MevBoost.Mode.Value = config.Mode_Local
+		cfg.MevBoost.SelectionMode.Value = config.MevSelectionMode_Relay
+		cfg.MevBoost.FlashbotsRelay.Value = true
+		cfg.ConsensusClient.Value = client
+		if errors := getMevBoostErrors(cfg); len(errors) != 0 {
+			t.Errorf("expected no MEV-Boost errors with %s, got %v", client, errors)
+		}
+	}
+}
+
+func TestMevBoostEnabled(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.EnableMevBoost.Value = true
+	if !cfg.MevBoostEnabled() {
+		t.Error("expected MEV-Boost to be enabled")
+	}
+	cfg.IsNativeMode = true
+	if cfg.MevBoostEnabled() {
+		t.Error("expected MEV-Boost to be disabled in Native mode")
+	}
+	cfg.IsNativeMode = false
+	cfg.EnableMevBoost.Value = false
+	if cfg.MevBoostEnabled() {
+		t.Error("expected MEV-Boost to be disabled")
+	}
+}
+
+func TestMevBoostMinBid(t *testing.T) {
+	tests := []struct {
+		name   string
+		minBid float64
+		valid  bool
+		flag   string
+	}{
+		{"any bid", 0, true, ""},
+		{"small bid", 0.05, true, "-min-bid=0.05"},
+		{"maximum bid", 1, true, "-min-bid=1"},
+		{"negative bid", -0.1, false, ""},
+		{"bid above the maximum", 1.5, false, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			err := cfg.MevBoost.MinBid.Validate(test.minBid)
+			if test.valid && err != nil {
+				t.Errorf("expected %v to be valid, got error: %s", test.minBid, err.Error())
+			}
+			if !test.valid {
+				if err == nil {
+					t.Errorf("expected %v to be rejected", test.minBid)
+				}
+				return
+			}
+
+			cfg.EnableMevBoost.Value = true
+			cfg.MevBoost.Mode.Value = config.Mode_Local
+			cfg.MevBoost.MinBid.Value = test.minBid
+			flag := cfg.GenerateEnvironmentVariables()["MEV_BOOST_MIN_BID_FLAG"]
+			if flag != test.flag {
+				t.Errorf("expected [%s], got [%s]", test.flag, flag)
+			}
+		})
+	}
+}
+
+func TestMevBoostRelayInclusion(t *testing.T) {
+	tests := []struct {
+		name     string
+		network  config.Network
+		setup    func(cfg *RocketPoolConfig)
+		expected []config.MevRelayID
+	}{
+		{
+			name:    "selected relays",
+			network: config.Network_Mainnet,
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.MevBoost.FlashbotsRelay.Value = true
+				cfg.MevBoost.EdenRelay.Value = true
+			},
+			expected: []config.MevRelayID{config.MevRelayID_Flashbots, config.MevRelayID_Eden},
+		},
+		{
+			name:    "excluded relay",
+			network: config.Network_Mainnet,
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.MevBoost.FlashbotsRelay.Value = false
+				cfg.MevBoost.EdenRelay.Value = true
+			},
+			expected: []config.MevRelayID{config.MevRelayID_Eden},
+		},
+		{
+			name:    "selected relay that isn't on the network",
+			network: config.Network_Prater,
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.MevBoost.FlashbotsRelay.Value = true
+				cfg.MevBoost.BloxRouteRegulatedRelay.Value = true
+			},
+			expected: []config.MevRelayID{config.MevRelayID_Flashbots},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Smartnode.Network.Value = test.network
+			cfg.MevBoost.SelectionMode.Value = config.MevSelectionMode_Relay
+			test.setup(cfg)
+
+			relays := cfg.MevBoost.GetEnabledMevRelays()
+			if len(relays) != len(test.expected) {
+				t.Fatalf("expected relays %v, got %d relays", test.expected, len(relays))
+			}
+			for i, relay := range relays {
+				if relay.ID != test.expected[i] {
+					t.Errorf("expected relay %d to be %s, got %s", i, test.expected[i], relay.ID)
+				}
+			}
+			if strings.Count(cfg.MevBoost.GetRelayString(), "https://") != len(test.expected) {
+				t.Errorf("expected the relay string to include %d relays, got [%s]", len(test.expected), cfg.MevBoost.GetRelayString())
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package migration
+
+// Older configs could save a Geth cache size of 0 (which happened when the system's total memory couldn't be read), but
+// the cache now has a minimum size; removing the setting makes it fall back to the default for this system's memory
+func resetZeroGethCache(serializedConfig map[string]map[string]string) error {
+	gethSettings, exists := serializedConfig["geth"]
+	if !exists {
+		return nil
+	}
+	if gethSettings["cache"] == "0" {
+		delete(gethSettings, "cache")
+	}
+	return nil
+}
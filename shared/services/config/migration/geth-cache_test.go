@@ -0,0 +1,42 @@
+package migration
+
+import "testing"
+
+func TestResetZeroGethCache(t *testing.T) {
+	tests := []struct {
+		name          string
+		cache         string
+		expectedCache string
+		expectReset   bool
+	}{
+		{"zero cache", "0", "", true},
+		{"custom cache", "4096", "4096", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serializedConfig := map[string]map[string]string{
+				"geth": {"cache": test.cache, "maxPeers": "50"},
+			}
+			err := resetZeroGethCache(serializedConfig)
+			if err != nil {
+				t.Fatalf("error resetting the cache: %s", err.Error())
+			}
+
+			cache, exists := serializedConfig["geth"]["cache"]
+			if test.expectReset && exists {
+				t.Errorf("expected the cache to be removed so it falls back to the default, got [%s]", cache)
+			}
+			if !test.expectReset && cache != test.expectedCache {
+				t.Errorf("expected the cache to stay [%s], got [%s]", test.expectedCache, cache)
+			}
+			if serializedConfig["geth"]["maxPeers"] != "50" {
+				t.Errorf("expected the other Geth settings to be left alone, got %v", serializedConfig["geth"])
+			}
+		})
+	}
+
+	if err := resetZeroGethCache(map[string]map[string]string{}); err != nil {
+		t.Errorf("expected no error for a config without Geth settings, got: %s", err.Error())
+	}
+}
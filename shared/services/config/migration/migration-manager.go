@@ -2,9 +2,11 @@ package migration
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-version"
+	"github.com/rocket-pool/smartnode/shared"
 )
 
 type ConfigUpgrader struct {
@@ -20,44 +22,84 @@ func UpdateConfig(serializedConfig map[string]map[string]string) error {
 		return err
 	}
 
-	// Create versions
-	v131, err := parseVersion("1.3.1")
+	// Get the upgrades to apply; configs made by a newer Smartnode are refused, since they may contain settings this version
+	// would misinterpret
+	upgraders, err := MigrationsBetween(configVersion.String(), shared.RocketPoolVersion)
 	if err != nil {
 		return err
 	}
 
-	// Create the collection of upgraders
-	upgraders := []ConfigUpgrader{
-		{
-			Version:     v131,
-			UpgradeFunc: upgradeFromV131,
-		},
+	// Apply them all in series
+	for _, upgrader := range upgraders {
+		err = upgrader.UpgradeFunc(serializedConfig)
+		if err != nil {
+			return fmt.Errorf("error applying upgrade for config version %s: %w", upgrader.Version.String(), err)
+		}
 	}
 
-	// Find the index of the provided config's version
-	targetIndex := -1
-	for i, upgrader := range upgraders {
-		if configVersion.LessThanOrEqual(upgrader.Version) {
-			targetIndex = i
-		}
+	// Fill in settings that were added without a new config format, based on whether they're present
+	err = acknowledgeExistingRootFs(serializedConfig)
+	if err != nil {
+		return fmt.Errorf("error acknowledging existing root filesystem access: %w", err)
+	}
+	err = resetZeroGethCache(serializedConfig)
+	if err != nil {
+		return fmt.Errorf("error resetting the Geth cache size: %w", err)
+	}
+
+	return nil
+
+}
+
+// Get the upgrades that need to be applied, in order, to bring a config made by Smartnode version `from` up to date with
+// version `to`. Returns an error if either version can't be parsed or if `from` is newer than `to`.
+func MigrationsBetween(from string, to string) ([]ConfigUpgrader, error) {
+	fromVersion, err := parseVersion(strings.TrimPrefix(from, "v"))
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := parseVersion(strings.TrimPrefix(to, "v"))
+	if err != nil {
+		return nil, err
+	}
+	if fromVersion.Core().GreaterThan(toVersion.Core()) {
+		return nil, fmt.Errorf("this config was created by Smartnode v%s, which is newer than the current version (v%s); please upgrade the Smartnode to use it", fromVersion.String(), toVersion.String())
 	}
 
-	// If there are no upgrades to apply, return
-	if targetIndex == -1 {
-		return nil
+	upgraders, err := getUpgraders()
+	if err != nil {
+		return nil, err
 	}
 
-	// If there are upgrades, start at the first applicable index and apply them all in series
-	for i := targetIndex; i < len(upgraders); i++ {
-		upgrader := upgraders[i]
-		err = upgrader.UpgradeFunc(serializedConfig)
-		if err != nil {
-			return fmt.Errorf("error applying upgrade for config version %s: %w", upgrader.Version.String(), err)
+	// Each upgrader converts configs made by its version (or earlier) to the format used after it
+	migrations := []ConfigUpgrader{}
+	for _, upgrader := range upgraders {
+		if fromVersion.Core().LessThanOrEqual(upgrader.Version) && upgrader.Version.LessThan(toVersion.Core()) {
+			migrations = append(migrations, upgrader)
 		}
 	}
+	return migrations, nil
+}
 
-	return nil
+// Get the collection of upgraders, sorted by version
+func getUpgraders() ([]ConfigUpgrader, error) {
+	// Create versions
+	v131, err := parseVersion("1.3.1")
+	if err != nil {
+		return nil, err
+	}
+
+	upgraders := []ConfigUpgrader{
+		{
+			Version:     v131,
+			UpgradeFunc: upgradeFromV131,
+		},
+	}
 
+	sort.Slice(upgraders, func(i, j int) bool {
+		return upgraders[i].Version.LessThan(upgraders[j].Version)
+	})
+	return upgraders, nil
 }
 
 // Get the Smartnode version that the given config was built with
@@ -0,0 +1,49 @@
+package migration
+
+import "testing"
+
+func TestMigrationsBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected []string
+		valid    bool
+	}{
+		{"before the v1.3.1 format", "1.3.0", "1.7.0", []string{"1.3.1"}, true},
+		{"made by v1.3.1", "1.3.1", "1.7.0", []string{"1.3.1"}, true},
+		{"with a v prefix", "v1.2.0", "v1.7.0", []string{"1.3.1"}, true},
+		{"prerelease of v1.3.1", "1.3.1-dev", "1.7.0", []string{"1.3.1"}, true},
+		{"after the v1.3.1 format", "1.4.0", "1.7.0", []string{}, true},
+		{"upgrading to v1.3.1", "1.3.0", "1.3.1", []string{}, true},
+		{"same version", "1.7.0", "1.7.0", []string{}, true},
+		{"prerelease of the same version", "1.7.0-dev", "1.7.0", []string{}, true},
+		{"newer than the current version", "1.8.0", "1.7.0", nil, false},
+		{"invalid from version", "latest", "1.7.0", nil, false},
+		{"invalid to version", "1.3.0", "", nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			migrations, err := MigrationsBetween(test.from, test.to)
+			if !test.valid {
+				if err == nil {
+					t.Fatalf("expected an error going from %s to %s, got %d migrations", test.from, test.to, len(migrations))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error going from %s to %s: %s", test.from, test.to, err.Error())
+			}
+
+			if len(migrations) != len(test.expected) {
+				t.Fatalf("expected %d migrations going from %s to %s, got %d", len(test.expected), test.from, test.to, len(migrations))
+			}
+			for i, migration := range migrations {
+				if migration.Version.String() != test.expected[i] {
+					t.Errorf("expected migration %d to be for v%s, got v%s", i, test.expected[i], migration.Version.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package migration
+
+// Configs saved before root filesystem access needed an acknowledgement don't have one; nodes that already had root
+// filesystem access enabled have been running with it deliberately, so they're treated as having acknowledged it
+func acknowledgeExistingRootFs(serializedConfig map[string]map[string]string) error {
+	exporterSettings, exists := serializedConfig["exporter"]
+	if !exists {
+		return nil
+	}
+	if _, exists := exporterSettings["rootFsAcknowledged"]; exists {
+		return nil
+	}
+	if exporterSettings["enableRootFs"] == "true" {
+		exporterSettings["rootFsAcknowledged"] = "true"
+	}
+	return nil
+}
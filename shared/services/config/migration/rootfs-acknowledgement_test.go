@@ -0,0 +1,28 @@
+package migration
+
+import "testing"
+
+func TestAcknowledgeExistingRootFs(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]string
+		expected string
+	}{
+		{"enabled before acknowledgements", map[string]string{"enableRootFs": "true"}, "true"},
+		{"disabled before acknowledgements", map[string]string{"enableRootFs": "false"}, ""},
+		{"already acknowledged", map[string]string{"enableRootFs": "true", "rootFsAcknowledged": "false"}, "false"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serializedConfig := map[string]map[string]string{"exporter": test.settings}
+			err := acknowledgeExistingRootFs(serializedConfig)
+			if err != nil {
+				t.Fatalf("error acknowledging root filesystem access: %s", err.Error())
+			}
+			if serializedConfig["exporter"]["rootFsAcknowledged"] != test.expected {
+				t.Errorf("expected the acknowledgement to be [%s], got [%s]", test.expected, serializedConfig["exporter"]["rootFsAcknowledged"])
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"runtime"
 
-	"github.com/pbnjay/memory"
 	"github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -12,6 +11,7 @@ import (
 const (
 	nethermindTagAmd64         string = "nethermind/nethermind:1.14.7"
 	nethermindTagArm64         string = "nethermind/nethermind:1.14.7"
+	nethermindTagBeta          string = "nethermind/nethermind:latest"
 	nethermindEventLogInterval int    = 25000
 	nethermindStopSignal       string = "SIGTERM"
 )
@@ -44,6 +44,9 @@ type NethermindConfig struct {
 	// Additional JSON RPC URLs
 	AdditionalUrls config.Parameter `yaml:"additionalUrls,omitempty"`
 
+	// The log level for Nethermind
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
 	// The Docker Hub tag for Nethermind
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -63,6 +66,7 @@ func NewNethermindConfig(cfg *RocketPoolConfig) *NethermindConfig {
 			config.ConsensusClient_Nimbus,
 			config.ConsensusClient_Prysm,
 			config.ConsensusClient_Teku,
+			config.ConsensusClient_Lodestar,
 		},
 
 		EventLogInterval: nethermindEventLogInterval,
@@ -72,7 +76,7 @@ func NewNethermindConfig(cfg *RocketPoolConfig) *NethermindConfig {
 			Name:                 "Cache (Memory Hint) Size",
 			Description:          "The amount of RAM (in MB) you want to suggest for Nethermind's cache. While there is no guarantee that Nethermind will stay under this limit, lower values are preferred for machines with less RAM.\n\nThe default value for this will be calculated dynamically based on your system's available RAM, but you can adjust it manually.",
 			Type:                 config.ParameterType_Uint,
-			Default:              map[config.Network]interface{}{config.Network_All: calculateNethermindCache()},
+			Default:              map[config.Network]interface{}{config.Network_All: calculateNethermindCache(getTotalMemoryGB())},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"EC_CACHE_SIZE"},
 			CanBeBlank:           false,
@@ -96,7 +100,7 @@ func NewNethermindConfig(cfg *RocketPoolConfig) *NethermindConfig {
 			Name:                 "In-Memory Pruning Cache Size",
 			Description:          "The amount of RAM (in MB) you want to dedicate to Nethermind for its in-memory pruning system. Higher values mean less writes to your SSD and slower overall database growth.\n\nThe default value for this will be calculated dynamically based on your system's available RAM, but you can adjust it manually.",
 			Type:                 config.ParameterType_Uint,
-			Default:              map[config.Network]interface{}{config.Network_All: calculateNethermindPruneMemSize()},
+			Default:              map[config.Network]interface{}{config.Network_All: calculateNethermindPruneMemSize(getTotalMemoryGB())},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"NETHERMIND_PRUNE_MEM_SIZE"},
 			CanBeBlank:           false,
@@ -127,6 +131,8 @@ func NewNethermindConfig(cfg *RocketPoolConfig) *NethermindConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		LogLevel: generateLogLevelParameter("Nethermind", []config.ContainerID{config.ContainerID_Eth1}),
+
 		ContainerTag: config.Parameter{
 			ID:                   "containerTag",
 			Name:                 "Container Tag",
@@ -154,9 +160,7 @@ func NewNethermindConfig(cfg *RocketPoolConfig) *NethermindConfig {
 }
 
 // Calculate the recommended size for Nethermind's cache based on the amount of system RAM
-func calculateNethermindCache() uint64 {
-	totalMemoryGB := memory.TotalMemory() / 1024 / 1024 / 1024
-
+func calculateNethermindCache(totalMemoryGB uint64) uint64 {
 	if totalMemoryGB == 0 {
 		return 0
 	} else if totalMemoryGB < 9 {
@@ -175,9 +179,7 @@ func calculateNethermindCache() uint64 {
 }
 
 // Calculate the recommended size for Nethermind's in-memory pruning based on the amount of system RAM
-func calculateNethermindPruneMemSize() uint64 {
-	totalMemoryGB := memory.TotalMemory() / 1024 / 1024 / 1024
-
+func calculateNethermindPruneMemSize(totalMemoryGB uint64) uint64 {
 	if totalMemoryGB == 0 {
 		return 0
 	} else if totalMemoryGB < 9 {
@@ -222,6 +224,7 @@ func (cfg *NethermindConfig) GetParameters() []*config.Parameter {
 		&cfg.PruneMemSize,
 		&cfg.AdditionalModules,
 		&cfg.AdditionalUrls,
+		&cfg.LogLevel,
 		&cfg.ContainerTag,
 		&cfg.AdditionalFlags,
 	}
@@ -9,6 +9,7 @@ import (
 const (
 	nimbusTagTest            string = "statusim/nimbus-eth2:multiarch-v22.11.0"
 	nimbusTagProd            string = "statusim/nimbus-eth2:multiarch-v22.11.0"
+	nimbusTagBeta            string = "statusim/nimbus-eth2:multiarch-latest"
 	defaultNimbusMaxPeersArm uint16 = 100
 	defaultNimbusMaxPeersAmd uint16 = 160
 )
@@ -23,6 +24,9 @@ type NimbusConfig struct {
 	// Common parameters that Nimbus doesn't support and should be hidden
 	UnsupportedCommonParams []string `yaml:"-"`
 
+	// The log level for Nimbus
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
 	// The Docker Hub tag for Nimbus
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -47,6 +51,8 @@ func NewNimbusConfig(cfg *RocketPoolConfig) *NimbusConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		LogLevel: generateLogLevelParameter("Nimbus", []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator}),
+
 		ContainerTag: config.Parameter{
 			ID:          "containerTag",
 			Name:        "Container Tag",
@@ -81,6 +87,7 @@ func NewNimbusConfig(cfg *RocketPoolConfig) *NimbusConfig {
 func (cfg *NimbusConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.MaxPeers,
+		&cfg.LogLevel,
 		&cfg.ContainerTag,
 		&cfg.AdditionalFlags,
 	}
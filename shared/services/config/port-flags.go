@@ -0,0 +1,193 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// A port setting that the Smartnode passes to a client with a command line flag
+type managedPortFlag struct {
+	// Get the port parameter
+	getParameter func(cfg *RocketPoolConfig) *config.Parameter
+
+	// The client flags that set the port; a port can need more than one (such as separate TCP and UDP P2P ports).
+	// If this is empty, the client has no way to change the port and will always use its built-in default.
+	flags []string
+}
+
+// Get the port flags the Smartnode manages for each Execution client
+func getExecutionClientPortFlags() map[config.ExecutionClient][]managedPortFlag {
+	return map[config.ExecutionClient][]managedPortFlag{
+		config.ExecutionClient_Geth: {
+			{getParameter: getEcHttpPort, flags: []string{"--http.port"}},
+			{getParameter: getEcWsPort, flags: []string{"--ws.port"}},
+			{getParameter: getEcEnginePort, flags: []string{"--authrpc.port"}},
+			{getParameter: getEcP2pPort, flags: []string{"--port"}},
+		},
+		config.ExecutionClient_Nethermind: {
+			{getParameter: getEcHttpPort, flags: []string{"--JsonRpc.Port"}},
+			{getParameter: getEcWsPort, flags: []string{"--JsonRpc.WebSocketsPort"}},
+			{getParameter: getEcEnginePort, flags: []string{"--JsonRpc.EnginePort"}},
+			{getParameter: getEcP2pPort, flags: []string{"--Network.P2PPort", "--Network.DiscoveryPort"}},
+		},
+		config.ExecutionClient_Besu: {
+			{getParameter: getEcHttpPort, flags: []string{"--rpc-http-port"}},
+			{getParameter: getEcWsPort, flags: []string{"--rpc-ws-port"}},
+			{getParameter: getEcEnginePort, flags: []string{"--engine-rpc-port"}},
+			{getParameter: getEcP2pPort, flags: []string{"--p2p-port"}},
+		},
+		config.ExecutionClient_Erigon: {
+			{getParameter: getEcHttpPort, flags: []string{"--http.port"}},
+			{getParameter: getEcWsPort, flags: []string{}},
+			{getParameter: getEcEnginePort, flags: []string{"--authrpc.port"}},
+			{getParameter: getEcP2pPort, flags: []string{"--port"}},
+		},
+	}
+}
+
+// Get the port flags the Smartnode manages for each Consensus client's Beacon Node
+func getConsensusClientPortFlags() map[config.ConsensusClient][]managedPortFlag {
+	return map[config.ConsensusClient][]managedPortFlag{
+		config.ConsensusClient_Lighthouse: {
+			{getParameter: getBnApiPort, flags: []string{"--http-port"}},
+			{getParameter: getBnP2pPort, flags: []string{"--port"}},
+		},
+		config.ConsensusClient_Lodestar: {
+			{getParameter: getBnApiPort, flags: []string{"--rest.port"}},
+			{getParameter: getBnP2pPort, flags: []string{"--port"}},
+		},
+		config.ConsensusClient_Nimbus: {
+			{getParameter: getBnApiPort, flags: []string{"--rest-port"}},
+			{getParameter: getBnP2pPort, flags: []string{"--tcp-port", "--udp-port"}},
+		},
+		config.ConsensusClient_Prysm: {
+			{getParameter: getBnApiPort, flags: []string{"--grpc-gateway-port"}},
+			{getParameter: getBnP2pPort, flags: []string{"--p2p-tcp-port", "--p2p-udp-port"}},
+			{getParameter: func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.Prysm.RpcPort }, flags: []string{"--rpc-port"}},
+		},
+		config.ConsensusClient_Teku: {
+			{getParameter: getBnApiPort, flags: []string{"--rest-api-port"}},
+			{getParameter: getBnP2pPort, flags: []string{"--p2p-port"}},
+		},
+	}
+}
+
+// Checks that the port settings of the locally managed clients will actually be applied by the flags the Smartnode passes to
+// them. If a port has been changed but the selected client has no flag for it, or if the client's additional flags override
+// one of the Smartnode's port flags, returns a list of issues describing them.
+func (cfg *RocketPoolConfig) ValidatePortFlags() []config.Issue {
+	issues := []config.Issue{}
+	if cfg.IsNativeMode {
+		return issues
+	}
+	network := cfg.Smartnode.Network.Value.(config.Network)
+
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		client := cfg.ExecutionClient.Value.(config.ExecutionClient)
+		portFlags, exists := getExecutionClientPortFlags()[client]
+		if exists {
+			additionalFlags := cfg.getExecutionClientAdditionalFlags(client)
+			issues = append(issues, cfg.checkPortFlags(string(client), portFlags, additionalFlags, network)...)
+		}
+	}
+
+	if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
+		client := cfg.ConsensusClient.Value.(config.ConsensusClient)
+		portFlags, exists := getConsensusClientPortFlags()[client]
+		if exists {
+			additionalFlags := cfg.getConsensusClientAdditionalBnFlags(client)
+			issues = append(issues, cfg.checkPortFlags(string(client), portFlags, additionalFlags, network)...)
+		}
+	}
+
+	return issues
+}
+
+// Checks a client's managed port flags against its port settings and additional flags
+func (cfg *RocketPoolConfig) checkPortFlags(clientName string, portFlags []managedPortFlag, additionalFlags string, network config.Network) []config.Issue {
+	issues := []config.Issue{}
+	for _, portFlag := range portFlags {
+		param := portFlag.getParameter(cfg)
+		if len(portFlag.flags) == 0 {
+			defaultValue, err := param.GetDefault(network)
+			if err == nil && param.Value != defaultValue {
+				issues = append(issues, config.Issue{
+					Severity: config.IssueSeverity_Warning,
+					Message:  fmt.Sprintf("You have changed [%s] to %v, but %s doesn't have a setting for it so it will keep using %v.", param.Name, param.Value, clientName, defaultValue),
+				})
+			}
+			continue
+		}
+
+		for _, flag := range portFlag.flags {
+			if hasFlag(additionalFlags, flag) {
+				issues = append(issues, config.Issue{
+					Severity: config.IssueSeverity_Warning,
+					Message:  fmt.Sprintf("Your additional %s flags include `%s`, which overrides the [%s] setting (%v). Please remove it from the additional flags and change [%s] instead.", clientName, flag, param.Name, param.Value, param.Name),
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// Get the additional command line flags for an Execution client
+func (cfg *RocketPoolConfig) getExecutionClientAdditionalFlags(client config.ExecutionClient) string {
+	var param *config.Parameter
+	switch client {
+	case config.ExecutionClient_Geth:
+		param = &cfg.Geth.AdditionalFlags
+	case config.ExecutionClient_Nethermind:
+		param = &cfg.Nethermind.AdditionalFlags
+	case config.ExecutionClient_Besu:
+		param = &cfg.Besu.AdditionalFlags
+	case config.ExecutionClient_Erigon:
+		param = &cfg.Erigon.AdditionalFlags
+	default:
+		return ""
+	}
+	flags, _ := param.Value.(string)
+	return flags
+}
+
+// Get the additional command line flags for a Consensus client's Beacon Node
+func (cfg *RocketPoolConfig) getConsensusClientAdditionalBnFlags(client config.ConsensusClient) string {
+	var param *config.Parameter
+	switch client {
+	case config.ConsensusClient_Lighthouse:
+		param = &cfg.Lighthouse.AdditionalBnFlags
+	case config.ConsensusClient_Lodestar:
+		param = &cfg.Lodestar.AdditionalBnFlags
+	case config.ConsensusClient_Nimbus:
+		param = &cfg.Nimbus.AdditionalFlags
+	case config.ConsensusClient_Prysm:
+		param = &cfg.Prysm.AdditionalBnFlags
+	case config.ConsensusClient_Teku:
+		param = &cfg.Teku.AdditionalBnFlags
+	default:
+		return ""
+	}
+	flags, _ := param.Value.(string)
+	return flags
+}
+
+// Check if a string of command line flags sets the given flag, either as `--flag=value` or `--flag value`
+func hasFlag(flags string, flag string) bool {
+	for _, field := range strings.Fields(flags) {
+		name := strings.SplitN(field, "=", 2)[0]
+		if strings.EqualFold(name, flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Getters for the common port parameters
+func getEcHttpPort(cfg *RocketPoolConfig) *config.Parameter   { return &cfg.ExecutionCommon.HttpPort }
+func getEcWsPort(cfg *RocketPoolConfig) *config.Parameter     { return &cfg.ExecutionCommon.WsPort }
+func getEcEnginePort(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ExecutionCommon.EnginePort }
+func getEcP2pPort(cfg *RocketPoolConfig) *config.Parameter    { return &cfg.ExecutionCommon.P2pPort }
+func getBnApiPort(cfg *RocketPoolConfig) *config.Parameter    { return &cfg.ConsensusCommon.ApiPort }
+func getBnP2pPort(cfg *RocketPoolConfig) *config.Parameter    { return &cfg.ConsensusCommon.P2pPort }
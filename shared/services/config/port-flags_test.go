@@ -0,0 +1,100 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestValidatePortFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(cfg *RocketPoolConfig)
+		expected []string
+	}{
+		{
+			name:     "defaults",
+			setup:    func(cfg *RocketPoolConfig) {},
+			expected: []string{},
+		},
+		{
+			name: "changed port with a flag",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ExecutionCommon.WsPort.Value = uint16(8556)
+			},
+			expected: []string{},
+		},
+		{
+			name: "changed port without a flag",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ExecutionClient.Value = config.ExecutionClient_Erigon
+				cfg.ExecutionCommon.WsPort.Value = uint16(8556)
+			},
+			expected: []string{"doesn't have a setting for it"},
+		},
+		{
+			name: "Execution client port overridden by additional flags",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.Geth.AdditionalFlags.Value = "--cache.gc=50 --http.port=9545"
+			},
+			expected: []string{"`--http.port`"},
+		},
+		{
+			name: "Consensus client port overridden by additional flags",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ConsensusClient.Value = config.ConsensusClient_Nimbus
+				cfg.Nimbus.AdditionalFlags.Value = "--udp-port 9002"
+			},
+			expected: []string{"`--udp-port`"},
+		},
+		{
+			name: "external clients",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ExecutionClientMode.Value = config.Mode_External
+				cfg.ConsensusClientMode.Value = config.Mode_External
+				cfg.Geth.AdditionalFlags.Value = "--http.port=9545"
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+			cfg.ConsensusClient.Value = config.ConsensusClient_Lighthouse
+			test.setup(cfg)
+
+			issues := cfg.ValidatePortFlags()
+			if len(issues) != len(test.expected) {
+				t.Fatalf("expected %d issues, got %v", len(test.expected), issues)
+			}
+			for i, issue := range issues {
+				if issue.Severity != config.IssueSeverity_Warning || !strings.Contains(issue.Message, test.expected[i]) {
+					t.Errorf("expected a warning containing [%s], got %v", test.expected[i], issue)
+				}
+			}
+		})
+	}
+}
+
+func TestHasFlag(t *testing.T) {
+	tests := []struct {
+		flags    string
+		flag     string
+		expected bool
+	}{
+		{"--http.port=9545", "--http.port", true},
+		{"--cache 512 --http.port 9545", "--http.port", true},
+		{"--jsonrpc.port=9545", "--JsonRpc.Port", true},
+		{"--http.portal=true", "--http.port", false},
+		{"", "--http.port", false},
+	}
+
+	for _, test := range tests {
+		if hasFlag(test.flags, test.flag) != test.expected {
+			t.Errorf("expected hasFlag(%q, %q) to be %t", test.flags, test.flag, test.expected)
+		}
+	}
+}
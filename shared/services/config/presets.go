@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Settings for a class of hardware
+type hardwarePreset struct {
+	// Cache size for the Execution clients that support one, in MB
+	ecCacheSize uint64
+
+	// Max number of P2P peers for the Execution client
+	ecMaxPeers uint16
+
+	// Max number of P2P peers for the Consensus client
+	ccMaxPeers uint16
+
+	// Max amount of disk space Prometheus should use for its metrics, or blank for no limit
+	prometheusRetentionSize string
+}
+
+// The available hardware presets
+var hardwarePresets = map[string]hardwarePreset{
+	"low-power": {
+		ecCacheSize:             512,
+		ecMaxPeers:              25,
+		ccMaxPeers:              25,
+		prometheusRetentionSize: "5GB",
+	},
+	"standard": {
+		ecCacheSize:             2048,
+		ecMaxPeers:              50,
+		ccMaxPeers:              50,
+		prometheusRetentionSize: "20GB",
+	},
+	"high-performance": {
+		ecCacheSize:             4096,
+		ecMaxPeers:              100,
+		ccMaxPeers:              100,
+		prometheusRetentionSize: "",
+	},
+}
+
+// Get the names of the available hardware presets
+func GetHardwarePresetNames() []string {
+	names := make([]string, 0, len(hardwarePresets))
+	for name := range hardwarePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Applies one of the hardware presets, which tunes the cache size, peer counts and metrics retention of every client for a
+// class of machine. None of these settings have network-specific defaults or are overwritten on upgrade, so the preset's
+// values are kept by ChangeNetwork and UpdateDefaults just like values the user entered themselves.
+func ApplyPreset(cfg *RocketPoolConfig, presetName string) error {
+	preset, exists := hardwarePresets[presetName]
+	if !exists {
+		return fmt.Errorf("unknown preset [%s]; the available presets are %s", presetName, strings.Join(GetHardwarePresetNames(), ", "))
+	}
+
+	// Execution clients
+	cfg.Geth.CacheSize.Value = preset.ecCacheSize
+	cfg.Geth.MaxPeers.Value = preset.ecMaxPeers
+	cfg.Nethermind.CacheSize.Value = preset.ecCacheSize
+	cfg.Nethermind.MaxPeers.Value = preset.ecMaxPeers
+	cfg.Besu.MaxPeers.Value = preset.ecMaxPeers
+	cfg.Erigon.MaxPeers.Value = preset.ecMaxPeers
+
+	// Consensus clients
+	cfg.Lighthouse.MaxPeers.Value = preset.ccMaxPeers
+	cfg.Lodestar.MaxPeers.Value = preset.ccMaxPeers
+	cfg.Nimbus.MaxPeers.Value = preset.ccMaxPeers
+	cfg.Prysm.MaxPeers.Value = preset.ccMaxPeers
+	cfg.Teku.MaxPeers.Value = preset.ccMaxPeers
+
+	// Metrics
+	cfg.Prometheus.RetentionSize.Value = preset.prometheusRetentionSize
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestApplyPreset(t *testing.T) {
+	lowPower := newTestConfig()
+	if err := ApplyPreset(lowPower, "low-power"); err != nil {
+		t.Fatalf("error applying the low-power preset: %s", err.Error())
+	}
+	highPerformance := newTestConfig()
+	if err := ApplyPreset(highPerformance, "high-performance"); err != nil {
+		t.Fatalf("error applying the high-performance preset: %s", err.Error())
+	}
+
+	if lowPower.Geth.CacheSize.Value.(uint64) >= highPerformance.Geth.CacheSize.Value.(uint64) {
+		t.Errorf("expected the low-power Geth cache (%v) to be lower than the high-performance one (%v)", lowPower.Geth.CacheSize.Value, highPerformance.Geth.CacheSize.Value)
+	}
+	if lowPower.Geth.MaxPeers.Value.(uint16) >= highPerformance.Geth.MaxPeers.Value.(uint16) {
+		t.Errorf("expected the low-power Geth peers (%v) to be lower than the high-performance ones (%v)", lowPower.Geth.MaxPeers.Value, highPerformance.Geth.MaxPeers.Value)
+	}
+	if lowPower.Lighthouse.MaxPeers.Value.(uint16) >= highPerformance.Lighthouse.MaxPeers.Value.(uint16) {
+		t.Errorf("expected the low-power Lighthouse peers (%v) to be lower than the high-performance ones (%v)", lowPower.Lighthouse.MaxPeers.Value, highPerformance.Lighthouse.MaxPeers.Value)
+	}
+
+	// Every preset should produce valid settings
+	for _, name := range GetHardwarePresetNames() {
+		cfg := newTestConfig()
+		if err := ApplyPreset(cfg, name); err != nil {
+			t.Fatalf("error applying the %s preset: %s", name, err.Error())
+		}
+		params := []*config.Parameter{
+			&cfg.Geth.CacheSize, &cfg.Geth.MaxPeers, &cfg.Nethermind.CacheSize, &cfg.Nethermind.MaxPeers, &cfg.Besu.MaxPeers,
+			&cfg.Erigon.MaxPeers, &cfg.Lighthouse.MaxPeers, &cfg.Lodestar.MaxPeers, &cfg.Nimbus.MaxPeers, &cfg.Prysm.MaxPeers,
+			&cfg.Teku.MaxPeers, &cfg.Prometheus.RetentionSize,
+		}
+		for _, param := range params {
+			if err := param.Validate(param.Value); err != nil {
+				t.Errorf("expected the %s preset to produce a valid %s, got error: %s", name, param.Name, err.Error())
+			}
+		}
+	}
+}
+
+func TestApplyUnknownPreset(t *testing.T) {
+	cfg := newTestConfig()
+	original := cfg.Geth.CacheSize.Value
+	if err := ApplyPreset(cfg, "raspberry-pi"); err == nil {
+		t.Error("expected an error applying an unknown preset")
+	}
+	if cfg.Geth.CacheSize.Value != original {
+		t.Errorf("expected the Geth cache to be left at %v, got %v", original, cfg.Geth.CacheSize.Value)
+	}
+}
+
+func TestPresetSurvivesDefaultChanges(t *testing.T) {
+	cfg := newTestConfig()
+	if err := ApplyPreset(cfg, "low-power"); err != nil {
+		t.Fatalf("error applying the low-power preset: %s", err.Error())
+	}
+	preset := hardwarePresets["low-power"]
+
+	checkPreset := func(step string) {
+		if cfg.Geth.CacheSize.Value != preset.ecCacheSize {
+			t.Errorf("expected the Geth cache to stay at %d after %s, got %v", preset.ecCacheSize, step, cfg.Geth.CacheSize.Value)
+		}
+		if cfg.Geth.MaxPeers.Value != preset.ecMaxPeers {
+			t.Errorf("expected the Geth peers to stay at %d after %s, got %v", preset.ecMaxPeers, step, cfg.Geth.MaxPeers.Value)
+		}
+		if cfg.Lighthouse.MaxPeers.Value != preset.ccMaxPeers {
+			t.Errorf("expected the Lighthouse peers to stay at %d after %s, got %v", preset.ccMaxPeers, step, cfg.Lighthouse.MaxPeers.Value)
+		}
+		if cfg.Prometheus.RetentionSize.Value != preset.prometheusRetentionSize {
+			t.Errorf("expected the Prometheus retention size to stay at %s after %s, got %v", preset.prometheusRetentionSize, step, cfg.Prometheus.RetentionSize.Value)
+		}
+	}
+
+	network := cfg.Smartnode.Network.Value.(config.Network)
+	newNetwork := config.Network_Mainnet
+	if network == config.Network_Mainnet {
+		newNetwork = config.Network_Prater
+	}
+	cfg.ChangeNetwork(newNetwork)
+	checkPreset("changing the network")
+
+	if err := cfg.UpdateDefaults(); err != nil {
+		t.Fatalf("error updating the defaults: %s", err.Error())
+	}
+	checkPreset("updating the defaults")
+}
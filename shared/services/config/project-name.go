@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Characters that Docker Compose strips from project names
+var invalidProjectNameCharsRegex = regexp.MustCompile("[^a-z0-9_-]")
+
+// Get the prefix Docker Compose attaches to the names of the networks, volumes, and containers it creates for this config's
+// project, such as `rocketpool_`. Compose lowercases the project name and drops any characters it doesn't allow, so two
+// project names that only differ by those characters share the same prefix.
+func (cfg *RocketPoolConfig) ResourcePrefix() string {
+	projectName := fmt.Sprint(cfg.Smartnode.ProjectName.Value)
+	projectName = invalidProjectNameCharsRegex.ReplaceAllString(strings.ToLower(projectName), "")
+	return projectName + "_"
+}
+
+// Checks a set of Rocket Pool configs that run on the same machine (such as a mainnet and a testnet installation) for ones whose
+// Docker resources would collide because they share a resource prefix; if any do, returns a list of issues describing them.
+// The configs are identified by their directories.
+func CheckResourcePrefixCollisions(configs []*RocketPoolConfig) []config.Issue {
+	issues := []config.Issue{}
+	directories := map[string][]string{}
+	prefixes := []string{}
+	for _, cfg := range configs {
+		prefix := cfg.ResourcePrefix()
+		if _, exists := directories[prefix]; !exists {
+			prefixes = append(prefixes, prefix)
+		}
+		directories[prefix] = append(directories[prefix], cfg.RocketPoolDirectory)
+	}
+
+	for _, prefix := range prefixes {
+		if len(directories[prefix]) < 2 {
+			continue
+		}
+		issues = append(issues, config.Issue{
+			Severity: config.IssueSeverity_Warning,
+			Message:  fmt.Sprintf("The configurations in %s all use the Docker resource prefix [%s], so their containers, networks, and volumes will collide. Please give each of them a different Project Name.", strings.Join(directories[prefix], ", "), prefix),
+		})
+	}
+	return issues
+}
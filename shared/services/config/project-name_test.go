@@ -0,0 +1,69 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourcePrefix(t *testing.T) {
+	tests := []struct {
+		projectName string
+		expected    string
+	}{
+		{"rocketpool", "rocketpool_"},
+		{"rocketpool-testnet", "rocketpool-testnet_"},
+		{"RocketPool", "rocketpool_"},
+		{"rocket.pool", "rocketpool_"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.projectName, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Smartnode.ProjectName.Value = test.projectName
+			prefix := cfg.ResourcePrefix()
+			if prefix != test.expected {
+				t.Errorf("expected a resource prefix of %s, got %s", test.expected, prefix)
+			}
+		})
+	}
+}
+
+func TestCheckResourcePrefixCollisions(t *testing.T) {
+	tests := []struct {
+		name         string
+		projectNames []string
+		collision    bool
+	}{
+		{"different project names", []string{"rocketpool", "rocketpool-testnet"}, false},
+		{"identical project names", []string{"rocketpool", "rocketpool"}, true},
+		{"normalized project names", []string{"rocketpool", "Rocket.Pool"}, true},
+		{"single config", []string{"rocketpool"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configs := []*RocketPoolConfig{}
+			for i, projectName := range test.projectNames {
+				cfg := newTestConfig()
+				cfg.RocketPoolDirectory = "/tmp/rocketpool-" + string(rune('a'+i))
+				cfg.Smartnode.ProjectName.Value = projectName
+				configs = append(configs, cfg)
+			}
+
+			issues := CheckResourcePrefixCollisions(configs)
+			if !test.collision && len(issues) != 0 {
+				t.Errorf("expected no collision warnings, got %v", issues)
+			}
+			if test.collision {
+				if len(issues) != 1 {
+					t.Fatalf("expected 1 collision warning, got %v", issues)
+				}
+				for _, cfg := range configs {
+					if !strings.Contains(issues[0].Message, cfg.RocketPoolDirectory) {
+						t.Errorf("expected the warning to mention %s, got [%s]", cfg.RocketPoolDirectory, issues[0].Message)
+					}
+				}
+			}
+		})
+	}
+}
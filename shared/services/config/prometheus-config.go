@@ -10,6 +10,8 @@ const prometheusTag string = "prom/prometheus:v2.40.5"
 // Defaults
 const defaultPrometheusPort uint16 = 9091
 const defaultPrometheusOpenPort bool = false
+const defaultPrometheusRetentionSize string = ""
+const defaultPrometheusScrapeInterval string = "15s"
 
 // Configuration for Prometheus
 type PrometheusConfig struct {
@@ -24,6 +26,18 @@ type PrometheusConfig struct {
 	// The Docker Hub tag for Prometheus
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
+	// The max amount of disk space to retain metrics for
+	RetentionSize config.Parameter `yaml:"retentionSize,omitempty"`
+
+	// How often to collect metrics from each service
+	ScrapeInterval config.Parameter `yaml:"scrapeInterval,omitempty"`
+
+	// The max number of CPU cores the container can use
+	CpuLimit config.Parameter `yaml:"cpuLimit,omitempty"`
+
+	// The max amount of RAM the container can use
+	MemoryLimit config.Parameter `yaml:"memoryLimit,omitempty"`
+
 	// Custom command line flags
 	AdditionalFlags config.Parameter `yaml:"additionalFlags,omitempty"`
 }
@@ -69,6 +83,35 @@ func NewPrometheusConfig(cfg *RocketPoolConfig) *PrometheusConfig {
 			OverwriteOnUpgrade:   true,
 		},
 
+		RetentionSize: config.Parameter{
+			ID:                   "retentionSize",
+			Name:                 "Retention Size",
+			Description:          "The maximum amount of disk space Prometheus should use to store its metrics, such as `10GB`. Once this is reached, the oldest metrics will be removed. This works alongside Prometheus's time-based retention; whichever limit is reached first will be applied.\n\nSupported units are B, KB, MB, GB, TB, PB, and EB. Leave this blank to only use time-based retention.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultPrometheusRetentionSize},
+			Regex:                "^[0-9]+(B|KB|MB|GB|TB|PB|EB)$",
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Prometheus},
+			EnvironmentVariables: []string{"PROMETHEUS_RETENTION_SIZE"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ScrapeInterval: config.Parameter{
+			ID:                   "scrapeInterval",
+			Name:                 "Scrape Interval",
+			Description:          "How often Prometheus should collect metrics from each of your services, as a duration such as `15s` or `1m`. Shorter intervals give more detailed graphs but use more disk space.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultPrometheusScrapeInterval},
+			Regex:                "^[1-9][0-9]*(s|m|h)$",
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Prometheus},
+			EnvironmentVariables: []string{"PROMETHEUS_SCRAPE_INTERVAL"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		CpuLimit:    generateCpuLimitParameter("Prometheus", config.ContainerID_Prometheus, "PROMETHEUS_CPU_LIMIT"),
+		MemoryLimit: generateMemoryLimitParameter("Prometheus", config.ContainerID_Prometheus, "PROMETHEUS_MEMORY_LIMIT"),
+
 		AdditionalFlags: config.Parameter{
 			ID:                   "additionalFlags",
 			Name:                 "Additional Prometheus Flags",
@@ -89,6 +132,10 @@ func (cfg *PrometheusConfig) GetParameters() []*config.Parameter {
 		&cfg.Port,
 		&cfg.OpenPort,
 		&cfg.ContainerTag,
+		&cfg.RetentionSize,
+		&cfg.ScrapeInterval,
+		&cfg.CpuLimit,
+		&cfg.MemoryLimit,
 		&cfg.AdditionalFlags,
 	}
 }
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrometheusRetentionSize(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  string
+		valid bool
+	}{
+		{"blank", "", true},
+		{"gigabytes", "10GB", true},
+		{"megabytes", "512MB", true},
+		{"bytes", "1048576B", true},
+		{"terabytes", "2TB", true},
+		{"lowercase unit", "10gb", false},
+		{"space before unit", "10 GB", false},
+		{"no unit", "10", false},
+		{"fractional", "1.5GB", false},
+		{"unknown unit", "10GiB", false},
+		{"no number", "GB", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.EnableMetrics.Value = true
+			cfg.Prometheus.RetentionSize.Value = test.size
+
+			errors := cfg.Validate()
+			hasError := false
+			for _, err := range errors {
+				if strings.Contains(err, cfg.Prometheus.RetentionSize.Name) {
+					hasError = true
+				}
+			}
+			if test.valid && hasError {
+				t.Errorf("expected [%s] to be valid, got errors: %v", test.size, errors)
+			}
+			if !test.valid && !hasError {
+				t.Errorf("expected [%s] to be rejected", test.size)
+			}
+
+			flag, exists := cfg.GenerateEnvironmentVariables()["PROMETHEUS_RETENTION_SIZE_FLAG"]
+			if test.size == "" && exists {
+				t.Errorf("expected no retention size flag, got %s", flag)
+			}
+			if test.valid && test.size != "" && flag != ", \"--storage.tsdb.retention.size="+test.size+"\"" {
+				t.Errorf("expected a retention size flag for [%s], got [%s]", test.size, flag)
+			}
+		})
+	}
+}
+
+func TestPrometheusRetentionSizeAndTime(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.EnableMetrics.Value = true
+	cfg.Prometheus.RetentionSize.Value = "50GB"
+	cfg.Prometheus.AdditionalFlags.Value = "--storage.tsdb.retention.time=30d"
+
+	envVars := cfg.GenerateEnvironmentVariables()
+	if envVars["PROMETHEUS_RETENTION_SIZE_FLAG"] != ", \"--storage.tsdb.retention.size=50GB\"" {
+		t.Errorf("expected the retention size flag, got [%s]", envVars["PROMETHEUS_RETENTION_SIZE_FLAG"])
+	}
+	if envVars["PROMETHEUS_ADDITIONAL_FLAGS"] != ", \"--storage.tsdb.retention.time=30d\"" {
+		t.Errorf("expected the retention time flag, got [%s]", envVars["PROMETHEUS_ADDITIONAL_FLAGS"])
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+	"gopkg.in/yaml.v2"
+)
+
+// The global section of a Prometheus config file
+type prometheusGlobalConfig struct {
+	ScrapeInterval string `yaml:"scrape_interval"`
+}
+
+// A static set of targets for a scrape job
+type prometheusStaticConfig struct {
+	Targets []string `yaml:"targets"`
+}
+
+// A scrape job in a Prometheus config file
+type prometheusScrapeConfig struct {
+	JobName       string                   `yaml:"job_name"`
+	StaticConfigs []prometheusStaticConfig `yaml:"static_configs"`
+}
+
+// The parts of a Prometheus config file that the Smartnode manages
+type prometheusFileConfig struct {
+	Global        prometheusGlobalConfig   `yaml:"global"`
+	ScrapeConfigs []prometheusScrapeConfig `yaml:"scrape_configs"`
+}
+
+// Generates the contents of a `prometheus.yml` file that scrapes the exporter and each of the metrics-enabled services,
+// using the configured scrape interval and metrics ports
+func (cfg *RocketPoolConfig) GeneratePrometheusConfig() ([]byte, error) {
+	if cfg.EnableMetrics.Value != true {
+		return nil, fmt.Errorf("metrics are disabled")
+	}
+
+	jobs := []prometheusScrapeConfig{
+		newPrometheusScrapeConfig("node", NodeContainerName, &cfg.NodeMetricsPort),
+		newPrometheusScrapeConfig("exporter", ExporterContainerName, &cfg.ExporterMetricsPort),
+	}
+	if cfg.EnableODaoMetrics.Value == true {
+		jobs = append(jobs, newPrometheusScrapeConfig("watchtower", WatchtowerContainerName, &cfg.WatchtowerMetricsPort))
+	}
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		jobs = append(jobs, newPrometheusScrapeConfig("eth1", Eth1ContainerName, &cfg.EcMetricsPort))
+	}
+	if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
+		jobs = append(jobs, newPrometheusScrapeConfig("eth2", Eth2ContainerName, &cfg.BnMetricsPort))
+	}
+	jobs = append(jobs, newPrometheusScrapeConfig("validator", ValidatorContainerName, &cfg.VcMetricsPort))
+
+	fileConfig := prometheusFileConfig{
+		Global: prometheusGlobalConfig{
+			ScrapeInterval: cfg.Prometheus.ScrapeInterval.Value.(string),
+		},
+		ScrapeConfigs: jobs,
+	}
+	bytes, err := yaml.Marshal(fileConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing Prometheus config: %w", err)
+	}
+	return bytes, nil
+}
+
+// Creates a scrape job for a single container's metrics port
+func newPrometheusScrapeConfig(jobName string, containerName string, port *config.Parameter) prometheusScrapeConfig {
+	return prometheusScrapeConfig{
+		JobName: jobName,
+		StaticConfigs: []prometheusStaticConfig{
+			{Targets: []string{fmt.Sprintf("%s:%v", containerName, port.Value)}},
+		},
+	}
+}
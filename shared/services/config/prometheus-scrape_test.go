@@ -0,0 +1,56 @@
+package config
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestGeneratePrometheusConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		odaoMetrics bool
+		watchtower  bool
+	}{
+		{"without oDAO metrics", false, false},
+		{"with oDAO metrics", true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.EnableMetrics.Value = true
+			cfg.EnableODaoMetrics.Value = test.odaoMetrics
+			cfg.ExporterMetricsPort.Value = uint16(9203)
+			cfg.Prometheus.ScrapeInterval.Value = "30s"
+
+			bytes, err := cfg.GeneratePrometheusConfig()
+			if err != nil {
+				t.Fatalf("error generating Prometheus config: %s", err.Error())
+			}
+			var fileConfig prometheusFileConfig
+			if err := yaml.Unmarshal(bytes, &fileConfig); err != nil {
+				t.Fatalf("error parsing Prometheus config: %s", err.Error())
+			}
+
+			if fileConfig.Global.ScrapeInterval != "30s" {
+				t.Errorf("expected a scrape interval of 30s, got %s", fileConfig.Global.ScrapeInterval)
+			}
+
+			targets := map[string][]string{}
+			for _, job := range fileConfig.ScrapeConfigs {
+				for _, staticConfig := range job.StaticConfigs {
+					targets[job.JobName] = append(targets[job.JobName], staticConfig.Targets...)
+				}
+			}
+			exporterTargets := targets["exporter"]
+			expected := ExporterContainerName + ":9203"
+			if len(exporterTargets) != 1 || exporterTargets[0] != expected {
+				t.Errorf("expected the exporter job to target %s, got %v", expected, exporterTargets)
+			}
+			if _, exists := targets["watchtower"]; exists != test.watchtower {
+				t.Errorf("expected the watchtower job to exist: %t, got %t", test.watchtower, exists)
+			}
+		})
+	}
+}
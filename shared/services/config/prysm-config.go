@@ -37,6 +37,9 @@ type PrysmConfig struct {
 	// Toggle for forwarding the RPC API outside of Docker
 	OpenRpcPort config.Parameter `yaml:"openRpcPort,omitempty"`
 
+	// The log level for Prysm
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
 	// The Docker Hub tag for the Prysm BN
 	BnContainerTag config.Parameter `yaml:"bnContainerTag,omitempty"`
 
@@ -93,6 +96,8 @@ func NewPrysmConfig(cfg *RocketPoolConfig) *PrysmConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		LogLevel: generateLogLevelParameter("Prysm", []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator}),
+
 		BnContainerTag: config.Parameter{
 			ID:          "bnContainerTag",
 			Name:        "Beacon Node Container Tag",
@@ -201,6 +206,7 @@ func (cfg *PrysmConfig) GetParameters() []*config.Parameter {
 		&cfg.MaxPeers,
 		&cfg.RpcPort,
 		&cfg.OpenRpcPort,
+		&cfg.LogLevel,
 		&cfg.BnContainerTag,
 		&cfg.VcContainerTag,
 		&cfg.AdditionalBnFlags,
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Constants
+const (
+	cpuLimitID             string = "cpuLimit"
+	memoryLimitID          string = "memoryLimit"
+	memoryLimitRegex       string = "^[1-9][0-9]*[kmg]$"
+	noResourceLimitsString string = "{}"
+)
+
+// Generates a parameter for limiting the number of CPU cores a container can use
+func generateCpuLimitParameter(clientName string, container config.ContainerID, envVar string) config.Parameter {
+	return config.Parameter{
+		ID:                   cpuLimitID,
+		Name:                 "CPU Limit",
+		Description:          fmt.Sprintf("The maximum number of CPU cores that the %s container is allowed to use. Fractional values (such as 1.5) are allowed.\n\nUse 0 for no limit.", clientName),
+		Type:                 config.ParameterType_Float,
+		Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+		MinValue:             float64(0),
+		AffectsContainers:    []config.ContainerID{container},
+		EnvironmentVariables: []string{envVar},
+		CanBeBlank:           false,
+		OverwriteOnUpgrade:   false,
+	}
+}
+
+// Generates a parameter for limiting the amount of RAM a container can use
+func generateMemoryLimitParameter(clientName string, container config.ContainerID, envVar string) config.Parameter {
+	return config.Parameter{
+		ID:                   memoryLimitID,
+		Name:                 "Memory Limit",
+		Description:          fmt.Sprintf("The maximum amount of RAM that the %s container is allowed to use, as a number followed by a unit (k, m, or g) such as `8g`. If the container exceeds this, Docker will kill it and restart it.\n\nLeave this blank for no limit.", clientName),
+		Type:                 config.ParameterType_String,
+		Default:              map[config.Network]interface{}{config.Network_All: ""},
+		Regex:                memoryLimitRegex,
+		AffectsContainers:    []config.ContainerID{container},
+		EnvironmentVariables: []string{envVar},
+		CanBeBlank:           true,
+		OverwriteOnUpgrade:   false,
+	}
+}
+
+// Get the Docker Compose `deploy` section for a container that applies the given resource limits, formatted so it can be
+// inserted into a compose file with a single environment variable.
+// Returns an empty section if neither limit is set.
+func GetDeployResourcesFragment(cpuLimit float64, memoryLimit string) string {
+	limits := ""
+	if cpuLimit > 0 {
+		limits = fmt.Sprintf("\"cpus\": \"%s\"", strconv.FormatFloat(cpuLimit, 'f', -1, 64))
+	}
+	if memoryLimit != "" {
+		if limits != "" {
+			limits += ", "
+		}
+		limits += fmt.Sprintf("\"memory\": \"%s\"", memoryLimit)
+	}
+
+	if limits == "" {
+		return noResourceLimitsString
+	}
+	return fmt.Sprintf("{\"resources\": {\"limits\": {%s}}}", limits)
+}
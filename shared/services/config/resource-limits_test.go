@@ -0,0 +1,94 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourceLimitValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		cpuLimit    float64
+		memoryLimit string
+		valid       bool
+	}{
+		{"no limits", 0, "", true},
+		{"whole cores", 4, "", true},
+		{"fractional cores", 1.5, "", true},
+		{"gigabytes", 0, "8g", true},
+		{"megabytes", 0, "512m", true},
+		{"both limits", 2, "16g", true},
+		{"negative cores", -1, "", false},
+		{"memory without a unit", 0, "8", false},
+		{"memory with a long unit", 0, "8gb", false},
+		{"memory with an uppercase unit", 0, "8G", false},
+		{"fractional memory", 0, "1.5g", false},
+		{"memory with a space", 0, "8 g", false},
+		{"zero memory", 0, "0g", false},
+		{"memory with a leading zero", 0, "08g", false},
+		{"memory in bytes", 0, "1b", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.ExecutionCommon.CpuLimit.Value = test.cpuLimit
+			cfg.ExecutionCommon.MemoryLimit.Value = test.memoryLimit
+
+			errors := []string{}
+			for _, err := range cfg.Validate() {
+				if strings.Contains(err, cfg.ExecutionCommon.CpuLimit.Name) || strings.Contains(err, cfg.ExecutionCommon.MemoryLimit.Name) {
+					errors = append(errors, err)
+				}
+			}
+			if test.valid && len(errors) > 0 {
+				t.Errorf("expected the limits to be valid, got errors: %v", errors)
+			}
+			if !test.valid && len(errors) == 0 {
+				t.Error("expected the limits to be rejected")
+			}
+		})
+	}
+}
+
+func TestGetDeployResourcesFragment(t *testing.T) {
+	tests := []struct {
+		name        string
+		cpuLimit    float64
+		memoryLimit string
+		expected    string
+	}{
+		{"no limits", 0, "", "{}"},
+		{"CPU only", 1.5, "", `{"resources": {"limits": {"cpus": "1.5"}}}`},
+		{"memory only", 0, "8g", `{"resources": {"limits": {"memory": "8g"}}}`},
+		{"both limits", 4, "16g", `{"resources": {"limits": {"cpus": "4", "memory": "16g"}}}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fragment := GetDeployResourcesFragment(test.cpuLimit, test.memoryLimit)
+			if fragment != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, fragment)
+			}
+		})
+	}
+}
+
+func TestDeployResourcesEnvironmentVariables(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.EnableMetrics.Value = true
+	cfg.ExecutionCommon.MemoryLimit.Value = "16g"
+	cfg.ConsensusCommon.CpuLimit.Value = float64(2)
+
+	envVars := cfg.GenerateEnvironmentVariables()
+	expected := map[string]string{
+		"EC_DEPLOY_RESOURCES":         `{"resources": {"limits": {"memory": "16g"}}}`,
+		"BN_DEPLOY_RESOURCES":         `{"resources": {"limits": {"cpus": "2"}}}`,
+		"PROMETHEUS_DEPLOY_RESOURCES": "{}",
+	}
+	for name, value := range expected {
+		if envVars[name] != value {
+			t.Errorf("expected %s to be %s, got %s", name, value, envVars[name])
+		}
+	}
+}
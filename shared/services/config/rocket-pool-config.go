@@ -1,16 +1,20 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/url"
 	"os"
 	"path/filepath"
-	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/alessio/shellescape"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pbnjay/memory"
 	"github.com/rocket-pool/smartnode/addons"
 	"github.com/rocket-pool/smartnode/shared"
@@ -48,6 +52,46 @@ const defaultExporterMetricsPort uint16 = 9103
 const defaultWatchtowerMetricsPort uint16 = 9104
 const defaultEcMetricsPort uint16 = 9105
 
+// Gets the total amount of system RAM, in bytes; this can be replaced to simulate other systems
+var totalMemory func() uint64 = memory.TotalMemory
+
+// Gets the total amount of system RAM, in GB; this is 0 if it couldn't be determined
+func getTotalMemoryGB() uint64 {
+	return totalMemory() / 1024 / 1024 / 1024
+}
+
+// Amounts of RAM (in GB) that cover each of the memory-dependent default calculations
+var simulatedMemorySizes = []uint64{4, 8, 12, 16, 24, 32, 64}
+
+// Estimated memory footprints of each client and service, in MB
+const smartnodeMemoryEstimate uint64 = 512
+const gethMemoryOverhead uint64 = 2048
+const nethermindMemoryOverhead uint64 = 2048
+const besuDefaultHeapEstimate uint64 = 5120
+const erigonMemoryEstimate uint64 = 4096
+const tekuDefaultHeapEstimate uint64 = 4096
+const jvmMemoryOverhead uint64 = 1024
+const lighthouseMemoryEstimate uint64 = 3072
+const nimbusMemoryEstimate uint64 = 2048
+const prysmMemoryEstimate uint64 = 4096
+const lodestarMemoryEstimate uint64 = 4096
+const validatorClientMemoryEstimate uint64 = 512
+const monitoringMemoryEstimate uint64 = 1024
+const mevBoostMemoryEstimate uint64 = 128
+
+// The placeholder used in place of secret values in redacted configs
+const redactedValue string = "REDACTED"
+
+// The percentage of the system RAM that the estimated footprint can use before a warning is issued
+const memoryWarningThreshold uint64 = 90
+
+// Limits on the size of the environment passed to a process, in bytes; these are the Linux defaults (MAX_ARG_STRLEN and ARG_MAX)
+const maxEnvVarSize int = 131072
+const maxEnvSize int = 2097152
+
+// The percentage of an environment size limit that can be used before a warning is issued
+const envSizeWarningThreshold int = 80
+
 // The master configuration struct
 type RocketPoolConfig struct {
 	Title string `yaml:"-"`
@@ -66,6 +110,9 @@ type RocketPoolConfig struct {
 	UseFallbackClients config.Parameter `yaml:"useFallbackClients,omitempty"`
 	ReconnectDelay     config.Parameter `yaml:"reconnectDelay,omitempty"`
 
+	// Fallback Validator client settings
+	UseFallbackValidator config.Parameter `yaml:"useFallbackValidator,omitempty"`
+
 	// Consensus client settings
 	ConsensusClientMode     config.Parameter `yaml:"consensusClientMode,omitempty"`
 	ConsensusClient         config.Parameter `yaml:"consensusClient,omitempty"`
@@ -90,6 +137,7 @@ type RocketPoolConfig struct {
 	Geth              *GethConfig              `yaml:"geth,omitempty"`
 	Nethermind        *NethermindConfig        `yaml:"nethermind,omitempty"`
 	Besu              *BesuConfig              `yaml:"besu,omitempty"`
+	Erigon            *ErigonConfig            `yaml:"erigon,omitempty"`
 	ExternalExecution *ExternalExecutionConfig `yaml:"externalExecution,omitempty"`
 
 	// Consensus client configurations
@@ -98,6 +146,7 @@ type RocketPoolConfig struct {
 	Nimbus             *NimbusConfig             `yaml:"nimbus,omitempty"`
 	Prysm              *PrysmConfig              `yaml:"prysm,omitempty"`
 	Teku               *TekuConfig               `yaml:"teku,omitempty"`
+	Lodestar           *LodestarConfig           `yaml:"lodestar,omitempty"`
 	ExternalLighthouse *ExternalLighthouseConfig `yaml:"externalLighthouse,omitempty"`
 	ExternalPrysm      *ExternalPrysmConfig      `yaml:"externalPrysm,omitempty"`
 	ExternalTeku       *ExternalTekuConfig       `yaml:"externalTeku,omitempty"`
@@ -106,6 +155,9 @@ type RocketPoolConfig struct {
 	FallbackNormal *FallbackNormalConfig `yaml:"fallbackNormal,omitempty"`
 	FallbackPrysm  *FallbackPrysmConfig  `yaml:"fallbackPrysm,omitempty"`
 
+	// Fallback Validator client configuration
+	FallbackValidator *FallbackValidatorConfig `yaml:"fallbackValidator,omitempty"`
+
 	// Metrics
 	Grafana           *GrafanaConfig           `yaml:"grafana,omitempty"`
 	Prometheus        *PrometheusConfig        `yaml:"prometheus,omitempty"`
@@ -121,37 +173,41 @@ type RocketPoolConfig struct {
 
 	// Addons
 	GraffitiWallWriter addontypes.SmartnodeAddon `yaml:"addon-gww,omitempty"`
+
+	// The regular defaults of the container tag parameters, before the update channel was applied
+	stableTagDefaults map[*config.Parameter]map[config.Network]interface{}
 }
 
-// Load configuration settings from a file
-func LoadFromFile(path string) (*RocketPoolConfig, error) {
+// Load configuration settings from a file. If strictMode is enabled, settings that don't correspond to any known parameter
+// cause an error; otherwise, they're ignored and returned as warnings.
+func LoadFromFile(path string, strictMode bool) (*RocketPoolConfig, []config.Issue, error) {
 
 	// Return nil if the file doesn't exist
 	_, err := os.Stat(path)
 	if os.IsNotExist(err) {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// Read the file
 	configBytes, err := ioutil.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("could not read Rocket Pool settings file at %s: %w", shellescape.Quote(path), err)
+		return nil, nil, fmt.Errorf("could not read Rocket Pool settings file at %s: %w", shellescape.Quote(path), err)
 	}
 
 	// Attempt to parse it out into a settings map
 	var settings map[string]map[string]string
 	if err := yaml.Unmarshal(configBytes, &settings); err != nil {
-		return nil, fmt.Errorf("could not parse settings file: %w", err)
+		return nil, nil, fmt.Errorf("could not parse settings file: %w", err)
 	}
 
 	// Deserialize it into a config object
 	cfg := NewRocketPoolConfig(filepath.Dir(path), false)
-	err = cfg.Deserialize(settings)
+	issues, err := cfg.Deserialize(settings, strictMode)
 	if err != nil {
-		return nil, fmt.Errorf("could not deserialize settings file: %w", err)
+		return nil, nil, fmt.Errorf("could not deserialize settings file: %w", err)
 	}
 
-	return cfg, nil
+	return cfg, issues, nil
 
 }
 
@@ -208,6 +264,10 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 				Name:        "Besu",
 				Description: getAugmentedEcDescription(config.ExecutionClient_Besu, "Hyperledger Besu is a robust full Ethereum protocol client. It uses a novel system called \"Bonsai Trees\" to store its chain data efficiently, which allows it to access block states from the past and does not require pruning. Besu is fully open source and written in Java."),
 				Value:       config.ExecutionClient_Besu,
+			}, {
+				Name:        "Erigon",
+				Description: getAugmentedEcDescription(config.ExecutionClient_Erigon, "Erigon is a full Ethereum protocol client focused on efficiency. It stores its chain data in a compact flat format that needs far less disk space than other clients, and it prunes itself automatically. Erigon is fully open source and written in Go."),
+				Value:       config.ExecutionClient_Erigon,
 			}},
 		},
 
@@ -235,6 +295,18 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		UseFallbackValidator: config.Parameter{
+			ID:                   "useFallbackValidator",
+			Name:                 "Use Fallback Validator Client",
+			Description:          "[orange]WARNING: Enabling this can get your validators slashed if you do not understand exactly what it does![white]\n\nEnable this to set up a second, backup Validator client with the same validator keys as your primary one. It must NEVER be allowed to attest or propose while your primary Validator client is also running; if both are active at the same time, your validators will be slashed and lose a significant amount of ETH.\n\nThis requires Doppelganger Protection to be enabled on your primary Validator client.\n\nNOTE: The Smartnode does not deploy the fallback Validator client yet. These settings are only recorded; nothing will run until it does.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		ConsensusClientMode: config.Parameter{
 			ID:                   "consensusClientMode",
 			Name:                 "Consensus Client Mode",
@@ -274,6 +346,10 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 				Name:        "Teku",
 				Description: "PegaSys Teku (formerly known as Artemis) is a Java-based Ethereum 2.0 client designed & built to meet institutional needs and security requirements. PegaSys is an arm of ConsenSys dedicated to building enterprise-ready clients and tools for interacting with the core Ethereum platform. Teku is Apache 2 licensed and written in Java, a language notable for its maturity & ubiquity.",
 				Value:       config.ConsensusClient_Teku,
+			}, {
+				Name:        "Lodestar",
+				Description: "Lodestar is a TypeScript implementation of the Ethereum Consensus protocol developed by ChainSafe Systems. It focuses on usability, modularity, and light client support, and is released under an LGPL-3.0 license.",
+				Value:       config.ConsensusClient_Lodestar,
 			}},
 		},
 
@@ -432,14 +508,17 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 	cfg.Geth = NewGethConfig(cfg)
 	cfg.Nethermind = NewNethermindConfig(cfg)
 	cfg.Besu = NewBesuConfig(cfg)
+	cfg.Erigon = NewErigonConfig(cfg)
 	cfg.ExternalExecution = NewExternalExecutionConfig(cfg)
 	cfg.FallbackNormal = NewFallbackNormalConfig(cfg)
 	cfg.FallbackPrysm = NewFallbackPrysmConfig(cfg)
+	cfg.FallbackValidator = NewFallbackValidatorConfig(cfg)
 	cfg.ConsensusCommon = NewConsensusCommonConfig(cfg)
 	cfg.Lighthouse = NewLighthouseConfig(cfg)
 	cfg.Nimbus = NewNimbusConfig(cfg)
 	cfg.Prysm = NewPrysmConfig(cfg)
 	cfg.Teku = NewTekuConfig(cfg)
+	cfg.Lodestar = NewLodestarConfig(cfg)
 	cfg.ExternalLighthouse = NewExternalLighthouseConfig(cfg)
 	cfg.ExternalPrysm = NewExternalPrysmConfig(cfg)
 	cfg.ExternalTeku = NewExternalTekuConfig(cfg)
@@ -465,7 +544,7 @@ func getAugmentedEcDescription(client config.ExecutionClient, originalDescriptio
 
 	switch client {
 	case config.ExecutionClient_Nethermind:
-		totalMemoryGB := memory.TotalMemory() / 1024 / 1024 / 1024
+		totalMemoryGB := getTotalMemoryGB()
 		if totalMemoryGB < 9 {
 			return fmt.Sprintf("%s\n\n[red]WARNING: Nethermind currently requires over 8 GB of RAM to run smoothly. We do not recommend it for your system. This may be improved in a future release.", originalDescription)
 		}
@@ -497,10 +576,74 @@ func (cfg *RocketPoolConfig) CreateCopy() *RocketPoolConfig {
 			newParams[i].UpdateDescription(network)
 		}
 	}
+	newConfig.applyUpdateChannel()
 
 	return newConfig
 }
 
+// Create a copy of this configuration with the values of all secret parameters replaced by a placeholder, so it can be
+// shared safely (e.g. in bug reports). Client URLs keep their scheme and host, but their credentials, paths, and queries
+// are redacted since providers often put API keys in them.
+func (cfg *RocketPoolConfig) Redacted() *RocketPoolConfig {
+	newConfig := cfg.CreateCopy()
+
+	redactParams := func(params []*config.Parameter) {
+		for _, param := range params {
+			if !param.Secret && isUrlParameter(param) {
+				value, ok := param.Value.(string)
+				if ok && value != "" {
+					param.Value = redactUrl(value)
+				}
+				continue
+			}
+			if !param.Secret || param.Value == "" || param.Value == nil {
+				continue
+			}
+
+			// Fall back to the default if the placeholder wouldn't pass the parameter's validation
+			if param.Validate(redactedValue) != nil {
+				param.SetToDefault(newConfig.Smartnode.Network.Value.(config.Network))
+				continue
+			}
+			param.Value = redactedValue
+		}
+	}
+
+	redactParams(newConfig.GetParameters())
+	for _, subconfig := range newConfig.GetSubconfigs() {
+		redactParams(subconfig.GetParameters())
+	}
+
+	return newConfig
+}
+
+// Check if a parameter holds the URL of a single client or service
+func isUrlParameter(param *config.Parameter) bool {
+	return param.Type == config.ParameterType_String && strings.HasSuffix(strings.ToLower(param.ID), "url")
+}
+
+// Removes everything from a URL that could hold a credential (the user info, path, query, and fragment), keeping the
+// scheme and host so it's still clear what the URL points to
+func redactUrl(value string) string {
+	parsedUrl, err := url.Parse(value)
+	if err != nil || parsedUrl.Host == "" {
+		return redactedValue
+	}
+
+	if parsedUrl.User != nil {
+		parsedUrl.User = url.User(redactedValue)
+	}
+	if parsedUrl.Path != "" && parsedUrl.Path != "/" {
+		parsedUrl.Path = "/" + redactedValue
+	}
+	parsedUrl.RawPath = ""
+	if parsedUrl.RawQuery != "" {
+		parsedUrl.RawQuery = redactedValue
+	}
+	parsedUrl.Fragment = ""
+	return parsedUrl.String()
+}
+
 // Get the parameters for this config
 func (cfg *RocketPoolConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
@@ -508,6 +651,7 @@ func (cfg *RocketPoolConfig) GetParameters() []*config.Parameter {
 		&cfg.ExecutionClient,
 		&cfg.UseFallbackClients,
 		&cfg.ReconnectDelay,
+		&cfg.UseFallbackValidator,
 		&cfg.ConsensusClientMode,
 		&cfg.ConsensusClient,
 		&cfg.ExternalConsensusClient,
@@ -532,17 +676,20 @@ func (cfg *RocketPoolConfig) GetSubconfigs() map[string]config.Config {
 		"geth":               cfg.Geth,
 		"nethermind":         cfg.Nethermind,
 		"besu":               cfg.Besu,
+		"erigon":             cfg.Erigon,
 		"externalExecution":  cfg.ExternalExecution,
 		"consensusCommon":    cfg.ConsensusCommon,
 		"lighthouse":         cfg.Lighthouse,
 		"nimbus":             cfg.Nimbus,
 		"prysm":              cfg.Prysm,
 		"teku":               cfg.Teku,
+		"lodestar":           cfg.Lodestar,
 		"externalLighthouse": cfg.ExternalLighthouse,
 		"externalPrysm":      cfg.ExternalPrysm,
 		"externalTeku":       cfg.ExternalTeku,
 		"fallbackNormal":     cfg.FallbackNormal,
 		"fallbackPrysm":      cfg.FallbackPrysm,
+		"fallbackValidator":  cfg.FallbackValidator,
 		"grafana":            cfg.Grafana,
 		"prometheus":         cfg.Prometheus,
 		"exporter":           cfg.Exporter,
@@ -553,8 +700,9 @@ func (cfg *RocketPoolConfig) GetSubconfigs() map[string]config.Config {
 	}
 }
 
-// Handle a network change on all of the parameters
-func (cfg *RocketPoolConfig) ChangeNetwork(newNetwork config.Network) {
+// Handle a network change on all of the parameters.
+// Returns a description of each network-specific setting that was reset to the new network's default.
+func (cfg *RocketPoolConfig) ChangeNetwork(newNetwork config.Network) []string {
 
 	// Get the current network
 	oldNetwork, ok := cfg.Smartnode.Network.Value.(config.Network)
@@ -562,10 +710,13 @@ func (cfg *RocketPoolConfig) ChangeNetwork(newNetwork config.Network) {
 		oldNetwork = config.Network_Unknown
 	}
 	if oldNetwork == newNetwork {
-		return
+		return []string{}
 	}
 	cfg.Smartnode.Network.Value = newNetwork
 
+	// Reset the network-specific parameters
+	changes := cfg.OnNetworkChange(oldNetwork, newNetwork)
+
 	// Update the master parameters
 	rootParams := cfg.GetParameters()
 	for _, param := range rootParams {
@@ -580,6 +731,47 @@ func (cfg *RocketPoolConfig) ChangeNetwork(newNetwork config.Network) {
 		}
 	}
 
+	return changes
+}
+
+// Reset all of the network-specific parameters (such as contract addresses and checkpoint sync URLs) to their defaults for the
+// new network, leaving network-agnostic settings like ports and cache sizes alone.
+// Returns a description of each setting that was changed.
+func (cfg *RocketPoolConfig) OnNetworkChange(oldNetwork config.Network, newNetwork config.Network) []string {
+	changes := []string{}
+	if oldNetwork == newNetwork {
+		return changes
+	}
+
+	resetParams := func(sectionTitle string, params []*config.Parameter) {
+		for _, param := range params {
+			param.UpdateDescription(newNetwork)
+			if !param.IsNetworkSpecific() {
+				continue
+			}
+			newDefault, err := param.GetDefault(newNetwork)
+			if err != nil {
+				continue
+			}
+			if param.Value != newDefault {
+				changes = append(changes, fmt.Sprintf("%s - %s: %v => %v", sectionTitle, param.Name, param.Value, newDefault))
+				param.Value = newDefault
+			}
+		}
+	}
+
+	resetParams(cfg.Title, cfg.GetParameters())
+	subconfigs := cfg.GetSubconfigs()
+	names := make([]string, 0, len(subconfigs))
+	for name := range subconfigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resetParams(subconfigs[name].GetConfigTitle(), subconfigs[name].GetParameters())
+	}
+
+	return changes
 }
 
 // Get the configuration for the selected execution client
@@ -599,6 +791,8 @@ func (cfg *RocketPoolConfig) GetEventLogInterval() (int, error) {
 			return cfg.Geth.EventLogInterval, nil
 		case config.ExecutionClient_Nethermind:
 			return cfg.Nethermind.EventLogInterval, nil
+		case config.ExecutionClient_Erigon:
+			return cfg.Erigon.EventLogInterval, nil
 		default:
 			return 0, fmt.Errorf("can't get event log interval of unknown execution client [%v]", client)
 		}
@@ -642,6 +836,8 @@ func (cfg *RocketPoolConfig) GetSelectedConsensusClientConfig() (config.Consensu
 			return cfg.Prysm, nil
 		case config.ConsensusClient_Teku:
 			return cfg.Teku, nil
+		case config.ConsensusClient_Lodestar:
+			return cfg.Lodestar, nil
 		default:
 			return nil, fmt.Errorf("unknown consensus client [%v] selected", client)
 		}
@@ -683,6 +879,8 @@ func (cfg *RocketPoolConfig) IsDoppelgangerEnabled() (bool, error) {
 			return cfg.ConsensusCommon.DoppelgangerDetection.Value.(bool), nil
 		case config.ConsensusClient_Teku:
 			return false, nil
+		case config.ConsensusClient_Lodestar:
+			return cfg.ConsensusCommon.DoppelgangerDetection.Value.(bool), nil
 		default:
 			return false, fmt.Errorf("unknown consensus client [%v] selected", client)
 		}
@@ -705,6 +903,21 @@ func (cfg *RocketPoolConfig) IsDoppelgangerEnabled() (bool, error) {
 	}
 }
 
+// Generates the contents of the fee recipient file that the Validator client will read, using the given fee recipient address
+func (cfg *RocketPoolConfig) GenerateFeeRecipientFile(feeRecipient common.Address) ([]byte, error) {
+	if feeRecipient == (common.Address{}) {
+		return nil, fmt.Errorf("fee recipient cannot be the zero address")
+	}
+
+	if cfg.IsNativeMode {
+		// Native mode needs an environment variable definition
+		return []byte(fmt.Sprintf("%s=%s", FeeRecipientEnvVar, feeRecipient.Hex())), nil
+	}
+
+	// Docker and Hybrid just need the address itself; each client's start script passes it along in the format that client expects
+	return []byte(feeRecipient.Hex()), nil
+}
+
 // Serializes the configuration into a map of maps, compatible with a settings file
 func (cfg *RocketPoolConfig) Serialize() map[string]map[string]string {
 
@@ -732,13 +945,15 @@ func (cfg *RocketPoolConfig) Serialize() map[string]map[string]string {
 	return masterMap
 }
 
-// Deserializes a settings file into this config
-func (cfg *RocketPoolConfig) Deserialize(masterMap map[string]map[string]string) error {
+// Deserializes a settings file into this config.
+// Settings that don't correspond to any known parameter are ignored and returned as a warning, unless strictMode is enabled -
+// in that case, they cause an error instead.
+func (cfg *RocketPoolConfig) Deserialize(masterMap map[string]map[string]string, strictMode bool) ([]config.Issue, error) {
 
 	// Upgrade the config to the latest version
 	err := migration.UpdateConfig(masterMap)
 	if err != nil {
-		return fmt.Errorf("error upgrading configuration to v%s: %w", shared.RocketPoolVersion, err)
+		return nil, fmt.Errorf("error upgrading configuration to v%s: %w", shared.RocketPoolVersion, err)
 	}
 
 	// Get the network
@@ -747,29 +962,35 @@ func (cfg *RocketPoolConfig) Deserialize(masterMap map[string]map[string]string)
 	if exists {
 		networkString, exists := smartnodeConfig[cfg.Smartnode.Network.ID]
 		if exists {
-			valueType := reflect.TypeOf(networkString)
-			paramType := reflect.TypeOf(network)
-			if !valueType.ConvertibleTo(paramType) {
-				return fmt.Errorf("can't get default network: value type %s cannot be converted to parameter type %s", valueType.Name(), paramType.Name())
+			var err error
+			network, err = config.NetworkFromString(networkString)
+			if err != nil {
+				return nil, fmt.Errorf("can't get default network: %w", err)
 			}
-			network = reflect.ValueOf(networkString).Convert(paramType).Interface().(config.Network)
 		}
 	}
 
+	// Get the update channel first, since it sets the defaults of the container tags
+	err = cfg.Smartnode.UpdateChannel.Deserialize(smartnodeConfig, network)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing update channel: %w", err)
+	}
+	cfg.applyUpdateChannel()
+
 	// Deserialize root params
 	rootParams := masterMap[rootConfigName]
 	for _, param := range cfg.GetParameters() {
 		// Note: if the root config doesn't exist, this will end up using the default values for all of its settings
 		err := param.Deserialize(rootParams, network)
 		if err != nil {
-			return fmt.Errorf("error deserializing root config: %w", err)
+			return nil, fmt.Errorf("error deserializing root config: %w", err)
 		}
 	}
 
 	cfg.RocketPoolDirectory = masterMap[rootConfigName]["rpDir"]
 	cfg.IsNativeMode, err = strconv.ParseBool(masterMap[rootConfigName]["isNative"])
 	if err != nil {
-		return fmt.Errorf("error parsing isNative: %w", err)
+		return nil, fmt.Errorf("error parsing isNative: %w", err)
 	}
 	cfg.Version = masterMap[rootConfigName]["version"]
 
@@ -780,12 +1001,62 @@ func (cfg *RocketPoolConfig) Deserialize(masterMap map[string]map[string]string)
 			// Note: if the subconfig doesn't exist, this will end up using the default values for all of its settings
 			err := param.Deserialize(subconfigParams, network)
 			if err != nil {
-				return fmt.Errorf("error deserializing [%s]: %w", name, err)
+				return nil, fmt.Errorf("error deserializing [%s]: %w", name, err)
 			}
+			NormalizeContainerImage(param)
 		}
 	}
 
-	return nil
+	// Check for typos and leftover settings
+	unrecognizedKeys := cfg.getUnrecognizedKeys(masterMap)
+	if len(unrecognizedKeys) > 0 {
+		if strictMode {
+			return nil, fmt.Errorf("settings contain unrecognized keys: %s", strings.Join(unrecognizedKeys, ", "))
+		}
+		return []config.Issue{{
+			Severity: config.IssueSeverity_Warning,
+			Message:  fmt.Sprintf("Ignoring unrecognized settings: %s", strings.Join(unrecognizedKeys, ", ")),
+		}}, nil
+	}
+
+	return []config.Issue{}, nil
+}
+
+// Get the keys in a serialized config that don't correspond to any known section or parameter, in the form `section.key`
+func (cfg *RocketPoolConfig) getUnrecognizedKeys(masterMap map[string]map[string]string) []string {
+	knownKeys := map[string]map[string]bool{
+		rootConfigName: {
+			"rpDir":    true,
+			"isNative": true,
+			"version":  true,
+		},
+	}
+	for _, param := range cfg.GetParameters() {
+		knownKeys[rootConfigName][param.ID] = true
+	}
+	for name, subconfig := range cfg.GetSubconfigs() {
+		knownKeys[name] = map[string]bool{}
+		for _, param := range subconfig.GetParameters() {
+			knownKeys[name][param.ID] = true
+		}
+	}
+
+	unrecognizedKeys := []string{}
+	for sectionName, section := range masterMap {
+		knownParams, exists := knownKeys[sectionName]
+		if !exists {
+			unrecognizedKeys = append(unrecognizedKeys, sectionName)
+			continue
+		}
+		for key := range section {
+			if !knownParams[key] {
+				unrecognizedKeys = append(unrecognizedKeys, fmt.Sprintf("%s.%s", sectionName, key))
+			}
+		}
+	}
+
+	sort.Strings(unrecognizedKeys)
+	return unrecognizedKeys
 }
 
 // Generates a collection of environment variables based on this config's settings
@@ -800,36 +1071,53 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 	envVars[FeeRecipientFileEnvVar] = FeeRecipientFilename // If this is running, we're in Docker mode by definition so use the Docker fee recipient filename
 	config.AddParametersToEnvVars(cfg.Smartnode.GetParameters(), envVars)
 	config.AddParametersToEnvVars(cfg.GetParameters(), envVars)
+	envVars[LoggingFragmentEnvVar] = GetLoggingFragment(cfg.Smartnode.LogMaxSize.Value.(string), cfg.Smartnode.LogMaxFiles.Value.(uint64))
 
 	// EC parameters
 	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
 		envVars["EC_CLIENT"] = fmt.Sprint(cfg.ExecutionClient.Value)
 		envVars["EC_HTTP_ENDPOINT"] = fmt.Sprintf("http://%s:%d", Eth1ContainerName, cfg.ExecutionCommon.HttpPort.Value)
-		envVars["EC_WS_ENDPOINT"] = fmt.Sprintf("ws://%s:%d", Eth1ContainerName, cfg.ExecutionCommon.WsPort.Value)
+		envVars["EC_WS_ENDPOINT"] = fmt.Sprintf("ws://%s:%d", Eth1ContainerName, cfg.getEcWsPort().Value)
 		envVars["EC_ENGINE_ENDPOINT"] = fmt.Sprintf("http://%s:%d", Eth1ContainerName, cfg.ExecutionCommon.EnginePort.Value)
 		envVars["EC_ENGINE_WS_ENDPOINT"] = fmt.Sprintf("ws://%s:%d", Eth1ContainerName, cfg.ExecutionCommon.EnginePort.Value)
 
 		// Handle open API ports
 		if cfg.ExecutionCommon.OpenRpcPorts.Value == true {
 			ecHttpPort := cfg.ExecutionCommon.HttpPort.Value.(uint16)
-			ecWsPort := cfg.ExecutionCommon.WsPort.Value.(uint16)
-			envVars["EC_OPEN_API_PORTS"] = fmt.Sprintf(", \"%d:%d/tcp\", \"%d:%d/tcp\"", ecHttpPort, ecHttpPort, ecWsPort, ecWsPort)
+			ecWsPort := cfg.getEcWsPort().Value.(uint16)
+			if ecWsPort == ecHttpPort {
+				envVars["EC_OPEN_API_PORTS"] = fmt.Sprintf(", \"%d:%d/tcp\"", ecHttpPort, ecHttpPort)
+			} else {
+				envVars["EC_OPEN_API_PORTS"] = fmt.Sprintf(", \"%d:%d/tcp\", \"%d:%d/tcp\"", ecHttpPort, ecHttpPort, ecWsPort, ecWsPort)
+			}
 		}
 
 		// Common params
 		config.AddParametersToEnvVars(cfg.ExecutionCommon.GetParameters(), envVars)
+		envVars["EC_DEPLOY_RESOURCES"] = GetDeployResourcesFragment(cfg.ExecutionCommon.CpuLimit.Value.(float64), cfg.ExecutionCommon.MemoryLimit.Value.(string))
+		envVars["EC_BOOTNODES_FLAG"] = GetExecutionClientBootnodesFlag(cfg.ExecutionClient.Value.(config.ExecutionClient), getBootnodes(&cfg.ExecutionCommon.Bootnodes))
 
 		// Client-specific params
 		switch cfg.ExecutionClient.Value.(config.ExecutionClient) {
 		case config.ExecutionClient_Geth:
 			config.AddParametersToEnvVars(cfg.Geth.GetParameters(), envVars)
 			envVars["EC_STOP_SIGNAL"] = gethStopSignal
+			envVars["EC_LOG_LEVEL_FLAG"] = GetExecutionClientLogLevelFlag(config.ExecutionClient_Geth, cfg.Geth.LogLevel.Value.(config.LogLevel))
+			if cfg.EnableMetrics.Value == true && cfg.Geth.EnableExpensiveMetrics.Value == true {
+				envVars["EC_METRICS_EXPENSIVE_FLAG"] = "--metrics.expensive"
+			}
 		case config.ExecutionClient_Nethermind:
 			config.AddParametersToEnvVars(cfg.Nethermind.GetParameters(), envVars)
 			envVars["EC_STOP_SIGNAL"] = nethermindStopSignal
+			envVars["EC_LOG_LEVEL_FLAG"] = GetExecutionClientLogLevelFlag(config.ExecutionClient_Nethermind, cfg.Nethermind.LogLevel.Value.(config.LogLevel))
 		case config.ExecutionClient_Besu:
 			config.AddParametersToEnvVars(cfg.Besu.GetParameters(), envVars)
 			envVars["EC_STOP_SIGNAL"] = besuStopSignal
+			envVars["EC_LOG_LEVEL_FLAG"] = GetExecutionClientLogLevelFlag(config.ExecutionClient_Besu, cfg.Besu.LogLevel.Value.(config.LogLevel))
+		case config.ExecutionClient_Erigon:
+			config.AddParametersToEnvVars(cfg.Erigon.GetParameters(), envVars)
+			envVars["EC_STOP_SIGNAL"] = erigonStopSignal
+			envVars["EC_LOG_LEVEL_FLAG"] = GetExecutionClientLogLevelFlag(config.ExecutionClient_Erigon, cfg.Erigon.LogLevel.Value.(config.LogLevel))
 		}
 	} else {
 		envVars["EC_CLIENT"] = "X" // X is for external / unknown
@@ -861,18 +1149,43 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 
 		// Common params
 		config.AddParametersToEnvVars(cfg.ConsensusCommon.GetParameters(), envVars)
+		envVars["BN_DEPLOY_RESOURCES"] = GetDeployResourcesFragment(cfg.ConsensusCommon.CpuLimit.Value.(float64), cfg.ConsensusCommon.MemoryLimit.Value.(string))
+		envVars["BN_BOOTNODES_FLAG"] = GetConsensusClientBootnodesFlag(consensusClient, getBootnodes(&cfg.ConsensusCommon.Bootnodes))
+		envVars["VC_SUGGESTED_GAS_LIMIT_FLAG"] = GetSuggestedGasLimitFlag(consensusClient, cfg.ConsensusCommon.SuggestedBlockGasLimit.Value.(uint64))
+
+		// Checkpoint sync verification
+		verifyRoot := cfg.ConsensusCommon.CheckpointVerifyRoot.Value.(string)
+		if cfg.ConsensusCommon.CheckpointSyncProvider.Value.(string) != "" && verifyRoot != "" {
+			envVars["CHECKPOINT_VERIFY_ROOT_FLAG"] = GetCheckpointVerifyRootFlag(consensusClient, verifyRoot)
+		}
+
+		// Historical state pruning, unless Teku has been asked to archive them
+		tekuArchive := (consensusClient == config.ConsensusClient_Teku && cfg.Teku.ArchiveMode.Value == true)
+		if cfg.ConsensusCommon.PruneHistoricalStates.Value == true && !tekuArchive {
+			envVars["BN_PRUNE_HISTORICAL_STATES_FLAG"] = GetPruneHistoricalStatesFlag(consensusClient)
+		}
 
 		// Client-specific params
 		switch consensusClient {
 		case config.ConsensusClient_Lighthouse:
 			config.AddParametersToEnvVars(cfg.Lighthouse.GetParameters(), envVars)
+			envVars["CC_LOG_LEVEL_FLAG"] = GetConsensusClientLogLevelFlag(config.ConsensusClient_Lighthouse, cfg.Lighthouse.LogLevel.Value.(config.LogLevel))
 		case config.ConsensusClient_Nimbus:
 			config.AddParametersToEnvVars(cfg.Nimbus.GetParameters(), envVars)
+			envVars["CC_LOG_LEVEL_FLAG"] = GetConsensusClientLogLevelFlag(config.ConsensusClient_Nimbus, cfg.Nimbus.LogLevel.Value.(config.LogLevel))
 		case config.ConsensusClient_Prysm:
 			config.AddParametersToEnvVars(cfg.Prysm.GetParameters(), envVars)
+			envVars["CC_LOG_LEVEL_FLAG"] = GetConsensusClientLogLevelFlag(config.ConsensusClient_Prysm, cfg.Prysm.LogLevel.Value.(config.LogLevel))
 			envVars["CC_RPC_ENDPOINT"] = fmt.Sprintf("http://%s:%d", Eth2ContainerName, cfg.Prysm.RpcPort.Value)
 		case config.ConsensusClient_Teku:
 			config.AddParametersToEnvVars(cfg.Teku.GetParameters(), envVars)
+			envVars["CC_LOG_LEVEL_FLAG"] = GetConsensusClientLogLevelFlag(config.ConsensusClient_Teku, cfg.Teku.LogLevel.Value.(config.LogLevel))
+			if cfg.UsesTekuProposerConfig() {
+				envVars["VC_PROPOSER_CONFIG_FLAG"] = getTekuProposerConfigFlag()
+			}
+		case config.ConsensusClient_Lodestar:
+			config.AddParametersToEnvVars(cfg.Lodestar.GetParameters(), envVars)
+			envVars["CC_LOG_LEVEL_FLAG"] = GetConsensusClientLogLevelFlag(config.ConsensusClient_Lodestar, cfg.Lodestar.LogLevel.Value.(config.LogLevel))
 		}
 	} else {
 		consensusClient = cfg.ExternalConsensusClient.Value.(config.ConsensusClient)
@@ -929,8 +1242,9 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 		config.AddParametersToEnvVars(cfg.Exporter.GetParameters(), envVars)
 		config.AddParametersToEnvVars(cfg.Prometheus.GetParameters(), envVars)
 		config.AddParametersToEnvVars(cfg.Grafana.GetParameters(), envVars)
+		envVars["PROMETHEUS_DEPLOY_RESOURCES"] = GetDeployResourcesFragment(cfg.Prometheus.CpuLimit.Value.(float64), cfg.Prometheus.MemoryLimit.Value.(string))
 
-		if cfg.Exporter.RootFs.Value == true {
+		if cfg.Exporter.RootFs.Value == true && cfg.Exporter.RootFsAcknowledged.Value == true {
 			envVars["EXPORTER_ROOTFS_COMMAND"] = ", \"--path.rootfs=/rootfs\""
 			envVars["EXPORTER_ROOTFS_VOLUME"] = ", \"/:/rootfs:ro\""
 		}
@@ -943,6 +1257,9 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 		if cfg.Exporter.AdditionalFlags.Value.(string) != "" {
 			envVars["EXPORTER_ADDITIONAL_FLAGS"] = fmt.Sprintf(", \"%s\"", cfg.Exporter.AdditionalFlags.Value.(string))
 		}
+		if cfg.Prometheus.RetentionSize.Value.(string) != "" {
+			envVars["PROMETHEUS_RETENTION_SIZE_FLAG"] = fmt.Sprintf(", \"--storage.tsdb.retention.size=%s\"", cfg.Prometheus.RetentionSize.Value.(string))
+		}
 		if cfg.Prometheus.AdditionalFlags.Value.(string) != "" {
 			envVars["PROMETHEUS_ADDITIONAL_FLAGS"] = fmt.Sprintf(", \"%s\"", cfg.Prometheus.AdditionalFlags.Value.(string))
 		}
@@ -959,6 +1276,7 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 		if cfg.MevBoost.Mode.Value == config.Mode_Local {
 			envVars[mevBoostRelaysEnvVar] = cfg.MevBoost.GetRelayString()
 			envVars[mevBoostUrlEnvVar] = fmt.Sprintf("http://%s:%d", MevBoostContainerName, cfg.MevBoost.Port.Value)
+			envVars["MEV_BOOST_MIN_BID_FLAG"] = GetMevBoostMinBidFlag(cfg.MevBoost.MinBid.Value.(float64))
 
 			// Handle open API port
 			if cfg.MevBoost.OpenRpcPort.Value == true {
@@ -975,6 +1293,182 @@ func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 
 }
 
+// Get a deterministic hash of all of the parameter values in this config, which can be used to quickly check if two configs are equivalent.
+// Parameters that are still set to a default calculated from the system's hardware are excluded so the hash doesn't change when the hardware does.
+func (cfg *RocketPoolConfig) Hash() string {
+	network := cfg.Smartnode.Network.Value.(config.Network)
+	hardwareParams := cfg.getHardwareDependentParameters()
+
+	entries := []string{
+		fmt.Sprintf("%s.isNative=%t", rootConfigName, cfg.IsNativeMode),
+	}
+	addEntries := func(sectionName string, params []*config.Parameter) {
+		for _, param := range params {
+			if hardwareParams[param] {
+				defaultValue, err := param.GetDefault(network)
+				if err == nil && fmt.Sprint(defaultValue) == fmt.Sprint(param.Value) {
+					continue
+				}
+			}
+			var value string
+			if param.Value != nil {
+				value = fmt.Sprint(param.Value)
+			}
+			entries = append(entries, fmt.Sprintf("%s.%s=%s", sectionName, param.ID, value))
+		}
+	}
+
+	addEntries(rootConfigName, cfg.GetParameters())
+	for name, subconfig := range cfg.GetSubconfigs() {
+		addEntries(name, subconfig.GetParameters())
+	}
+
+	sort.Strings(entries)
+	hash := sha256.Sum256([]byte(strings.Join(entries, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+// Get the parameters with default values that are calculated from the system's hardware (such as the amount of RAM or the CPU architecture)
+func (cfg *RocketPoolConfig) getHardwareDependentParameters() map[*config.Parameter]bool {
+	return map[*config.Parameter]bool{
+		&cfg.Geth.CacheSize:          true,
+		&cfg.Geth.MaxPeers:           true,
+		&cfg.Nethermind.CacheSize:    true,
+		&cfg.Nethermind.MaxPeers:     true,
+		&cfg.Nethermind.PruneMemSize: true,
+		&cfg.Nethermind.ContainerTag: true,
+		&cfg.Besu.JvmHeapSize:        true,
+		&cfg.Nimbus.MaxPeers:         true,
+		&cfg.Prysm.BnContainerTag:    true,
+		&cfg.Prysm.VcContainerTag:    true,
+		&cfg.Teku.JvmHeapSize:        true,
+		&cfg.MevBoost.ContainerTag:   true,
+	}
+}
+
+// Get the parameters with default values that are calculated from the system's RAM, along with their calculations
+func (cfg *RocketPoolConfig) getMemoryDependentDefaults() map[*config.Parameter]func(totalMemoryGB uint64) uint64 {
+	return map[*config.Parameter]func(uint64) uint64{
+		&cfg.Geth.CacheSize:          calculateGethCache,
+		&cfg.Nethermind.CacheSize:    calculateNethermindCache,
+		&cfg.Nethermind.PruneMemSize: calculateNethermindPruneMemSize,
+		&cfg.Besu.JvmHeapSize:        calculateBesuHeapSize,
+		&cfg.Teku.JvmHeapSize:        getTekuHeapSize,
+	}
+}
+
+// Get the memory-dependent parameters that are still set to a default calculated for a different amount of RAM than this
+// system has, which usually means the hardware changed after they were saved. The user may want to revert them to the current
+// calculated defaults.
+func (cfg *RocketPoolConfig) SuggestDefaultReverts() []*config.Parameter {
+	network := cfg.Smartnode.Network.Value.(config.Network)
+	memoryDefaults := cfg.getMemoryDependentDefaults()
+
+	suggestions := []*config.Parameter{}
+	for _, subconfig := range cfg.GetSubconfigs() {
+		for _, param := range subconfig.GetParameters() {
+			calculateDefault, exists := memoryDefaults[param]
+			if !exists {
+				continue
+			}
+			currentDefault, err := param.GetDefault(network)
+			if err != nil || fmt.Sprint(param.Value) == fmt.Sprint(currentDefault) {
+				continue
+			}
+
+			// Check if the value matches what another system would have calculated
+			for _, memoryGB := range simulatedMemorySizes {
+				if fmt.Sprint(param.Value) == fmt.Sprint(calculateDefault(memoryGB)) {
+					suggestions = append(suggestions, param)
+					break
+				}
+			}
+		}
+	}
+
+	return suggestions
+}
+
+// Get the parameters used by the currently selected clients and services, keyed by the name of the section they belong to.
+// This follows the same selection logic as GenerateEnvironmentVariables.
+func (cfg *RocketPoolConfig) getActiveParameters() map[string][]*config.Parameter {
+	activeParams := map[string][]*config.Parameter{
+		rootConfigName: cfg.GetParameters(),
+		"smartnode":    cfg.Smartnode.GetParameters(),
+		"addons-gww":   cfg.GraffitiWallWriter.GetConfig().GetParameters(),
+	}
+
+	// EC parameters
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		activeParams["executionCommon"] = cfg.ExecutionCommon.GetParameters()
+		switch cfg.ExecutionClient.Value.(config.ExecutionClient) {
+		case config.ExecutionClient_Geth:
+			activeParams["geth"] = cfg.Geth.GetParameters()
+		case config.ExecutionClient_Nethermind:
+			activeParams["nethermind"] = cfg.Nethermind.GetParameters()
+		case config.ExecutionClient_Besu:
+			activeParams["besu"] = cfg.Besu.GetParameters()
+		case config.ExecutionClient_Erigon:
+			activeParams["erigon"] = cfg.Erigon.GetParameters()
+		}
+	} else {
+		activeParams["externalExecution"] = cfg.ExternalExecution.GetParameters()
+	}
+
+	// CC parameters
+	consensusClient, mode := cfg.GetSelectedConsensusClient()
+	if mode == config.Mode_Local {
+		activeParams["consensusCommon"] = cfg.ConsensusCommon.GetParameters()
+		switch consensusClient {
+		case config.ConsensusClient_Lighthouse:
+			activeParams["lighthouse"] = cfg.Lighthouse.GetParameters()
+		case config.ConsensusClient_Nimbus:
+			activeParams["nimbus"] = cfg.Nimbus.GetParameters()
+		case config.ConsensusClient_Prysm:
+			activeParams["prysm"] = cfg.Prysm.GetParameters()
+		case config.ConsensusClient_Teku:
+			activeParams["teku"] = cfg.Teku.GetParameters()
+		case config.ConsensusClient_Lodestar:
+			activeParams["lodestar"] = cfg.Lodestar.GetParameters()
+		}
+	} else {
+		switch consensusClient {
+		case config.ConsensusClient_Lighthouse:
+			activeParams["externalLighthouse"] = cfg.ExternalLighthouse.GetParameters()
+		case config.ConsensusClient_Prysm:
+			activeParams["externalPrysm"] = cfg.ExternalPrysm.GetParameters()
+		case config.ConsensusClient_Teku:
+			activeParams["externalTeku"] = cfg.ExternalTeku.GetParameters()
+		}
+	}
+
+	// Fallback parameters
+	if cfg.UseFallbackClients.Value == true {
+		if consensusClient == config.ConsensusClient_Prysm {
+			activeParams["fallbackPrysm"] = cfg.FallbackPrysm.GetParameters()
+		} else {
+			activeParams["fallbackNormal"] = cfg.FallbackNormal.GetParameters()
+		}
+	}
+
+	// Metrics
+	if cfg.EnableMetrics.Value == true {
+		activeParams["exporter"] = cfg.Exporter.GetParameters()
+		activeParams["prometheus"] = cfg.Prometheus.GetParameters()
+		activeParams["grafana"] = cfg.Grafana.GetParameters()
+	}
+	if cfg.EnableBitflyNodeMetrics.Value == true {
+		activeParams["bitflyNodeMetrics"] = cfg.BitflyNodeMetrics.GetParameters()
+	}
+
+	// MEV-Boost
+	if cfg.EnableMevBoost.Value == true {
+		activeParams["mevBoost"] = cfg.MevBoost.GetParameters()
+	}
+
+	return activeParams
+}
+
 // The the title for the config
 func (cfg *RocketPoolConfig) GetConfigTitle() string {
 	return cfg.Title
@@ -1035,6 +1529,25 @@ func (cfg *RocketPoolConfig) GetChanges(oldConfig *RocketPoolConfig) (map[string
 	return changedSettings, totalAffectedContainers, changeNetworks
 }
 
+// Get the containers that need to be restarted for the changes between an old config and this one to take effect, in a
+// stable order
+func (cfg *RocketPoolConfig) GetAffectedContainers(oldConfig *RocketPoolConfig) []config.ContainerID {
+	_, totalAffectedContainers, _ := cfg.GetChanges(oldConfig)
+	return GetSortedContainers(totalAffectedContainers)
+}
+
+// Converts a set of containers into a list sorted by name, so they're always displayed and restarted in the same order
+func GetSortedContainers(containers map[config.ContainerID]bool) []config.ContainerID {
+	sortedContainers := make([]config.ContainerID, 0, len(containers))
+	for container := range containers {
+		sortedContainers = append(sortedContainers, container)
+	}
+	sort.Slice(sortedContainers, func(i, j int) bool {
+		return sortedContainers[i] < sortedContainers[j]
+	})
+	return sortedContainers
+}
+
 // Checks to see if the current configuration is valid; if not, returns a list of errors
 func (cfg *RocketPoolConfig) Validate() []string {
 	errors := []string{}
@@ -1056,6 +1569,24 @@ func (cfg *RocketPoolConfig) Validate() []string {
 	}
 	*/
 
+	// Check the parameters against their constraints
+	network := cfg.Smartnode.Network.Value.(config.Network)
+	for _, params := range cfg.getActiveParameters() {
+		for _, param := range params {
+			err := param.ValidateForNetwork(param.Value, network)
+			if err != nil {
+				errors = append(errors, err.Error())
+				continue
+			}
+			if isContainerTagParameter(param) {
+				err = ValidateContainerImage(param)
+				if err != nil {
+					errors = append(errors, err.Error())
+				}
+			}
+		}
+	}
+
 	// Force switching of Pocket and Infura
 	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
 		selectedEc := cfg.ExecutionClient.Value.(config.ExecutionClient)
@@ -1075,7 +1606,7 @@ func (cfg *RocketPoolConfig) Validate() []string {
 	}
 
 	// Ensure there's a MEV-boost URL
-	if !cfg.IsNativeMode && cfg.EnableMevBoost.Value == true {
+	if cfg.MevBoostEnabled() {
 		switch cfg.MevBoost.Mode.Value.(config.Mode) {
 		case config.Mode_Local:
 			// In local MEV-boost mode, the user has to have at least one relay
@@ -1093,9 +1624,557 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		}
 	}
 
+	// Ensure the builder registration overrides are formatted properly
+	if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
+		errors = append(errors, cfg.ConsensusCommon.validateBuilderRegistrationOverrides()...)
+	}
+
+	// Ensure root filesystem access for the Node Exporter was enabled deliberately
+	if !cfg.IsNativeMode && cfg.EnableMetrics.Value == true {
+		errors = append(errors, cfg.Exporter.validateRootFs()...)
+	}
+
+	// Ensure the automatic backup settings are usable
+	errors = append(errors, cfg.Smartnode.validateBackupSettings()...)
+
+	// Ensure the custom bootnodes are formatted properly
+	if !cfg.IsNativeMode {
+		if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+			errors = append(errors, validateBootnodes(cfg.ExecutionCommon.Title, &cfg.ExecutionCommon.Bootnodes, true)...)
+		}
+		if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
+			errors = append(errors, validateBootnodes(cfg.ConsensusCommon.Title, &cfg.ConsensusCommon.Bootnodes, false)...)
+		}
+	}
+
+	// Ensure the custom CA bundle can be loaded
+	caBundlePath := cfg.Smartnode.GetCaBundlePath(false)
+	if caBundlePath != "" {
+		_, err := LoadCaBundle(caBundlePath)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("[%s - %s] is not valid: %s", cfg.Smartnode.Title, cfg.Smartnode.CaBundlePath.Name, err.Error()))
+		}
+	}
+
+	// Ensure the fallback Validator client has a Beacon Node to connect to
+	if !cfg.IsNativeMode && cfg.UseFallbackValidator.Value == true {
+		if cfg.FallbackValidator.ApiUrl.Value.(string) == "" {
+			errors = append(errors, "You have the fallback Validator client enabled but don't have a Beacon Node URL set for it. Please enter the URL of the Beacon Node it should connect to, or disable it.")
+		}
+		doppelgangerEnabled, err := cfg.IsDoppelgangerEnabled()
+		if err != nil || !doppelgangerEnabled {
+			errors = append(errors, "You have the fallback Validator client enabled but your primary Validator client does not have Doppelganger Protection enabled, so it could start attesting while the fallback one is still active and get your validators slashed. Please enable Doppelganger Protection (with a client that supports it), or disable the fallback Validator client.")
+		}
+	}
+
+	// Ensure none of the ports collide
+	errors = append(errors, cfg.CheckPortConflicts()...)
+
 	return errors
 }
 
+// Get the warnings about potential problems with the configuration that don't prevent it from being saved
+func (cfg *RocketPoolConfig) GetWarnings() []string {
+	warnings := cfg.CheckFallbackValidatorRisks()
+	warnings = append(warnings, cfg.CheckLocalhostUrls()...)
+	warnings = append(warnings, cfg.CheckCheckpointSyncVerification()...)
+	warnings = append(warnings, cfg.CheckHistoricalStatePruning()...)
+
+	issues := cfg.ValidateTotalMemory()
+	issues = append(issues, cfg.CheckEnvironmentSizeLimits()...)
+	issues = append(issues, cfg.ValidateAgainstClientVersions()...)
+	issues = append(issues, cfg.ValidatePortFlags()...)
+	for _, issue := range issues {
+		warnings = append(warnings, issue.Message)
+	}
+	return warnings
+}
+
+// Check if MEV-Boost is enabled for the Smartnode's clients
+func (cfg *RocketPoolConfig) MevBoostEnabled() bool {
+	return !cfg.IsNativeMode && cfg.EnableMevBoost.Value == true
+}
+
+// Checks to see if the fallback Validator client is enabled; if so, returns a list of warnings about the slashing risks that
+// come with it
+func (cfg *RocketPoolConfig) CheckFallbackValidatorRisks() []string {
+	warnings := []string{}
+	if cfg.IsNativeMode || cfg.UseFallbackValidator.Value != true {
+		return warnings
+	}
+
+	warnings = append(warnings, "[orange]You have the fallback Validator client enabled, but the Smartnode does not deploy it yet, so it will NOT run as a backup for your primary Validator client. If you run one yourself, remember that it uses the same validator keys as your primary Validator client; if both of them are ever active at the same time, your validators WILL be slashed.[yellow]")
+
+	consensusClient, mode := cfg.GetSelectedConsensusClient()
+	if mode == config.Mode_Local && consensusClient == cfg.FallbackValidator.Client.Value.(config.ConsensusClient) {
+		warnings = append(warnings, "Your fallback Validator client is the same client as your primary one, so it will not protect you from bugs in that client. Consider choosing a different client for it.")
+	}
+
+	return warnings
+}
+
+// Get the list of containers that will be deployed for the current configuration
+func (cfg *RocketPoolConfig) EnabledContainers() []config.ContainerID {
+	if cfg.IsNativeMode {
+		return []config.ContainerID{}
+	}
+
+	containers := []config.ContainerID{
+		config.ContainerID_Api,
+		config.ContainerID_Node,
+		config.ContainerID_Watchtower,
+		config.ContainerID_Validator,
+	}
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		containers = append(containers, config.ContainerID_Eth1)
+	}
+	if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
+		containers = append(containers, config.ContainerID_Eth2)
+	}
+	if cfg.EnableMetrics.Value == true {
+		containers = append(containers, config.ContainerID_Grafana, config.ContainerID_Exporter, config.ContainerID_Prometheus)
+	}
+	if cfg.EnableMevBoost.Value == true && cfg.MevBoost.Mode.Value.(config.Mode) == config.Mode_Local {
+		containers = append(containers, config.ContainerID_MevBoost)
+	}
+
+	return containers
+}
+
+// Checks to see if Checkpoint Sync is enabled without a verification root on a client that can use one; if so, returns a
+// list of warnings
+func (cfg *RocketPoolConfig) CheckCheckpointSyncVerification() []string {
+	warnings := []string{}
+	if cfg.IsNativeMode || cfg.ConsensusClientMode.Value.(config.Mode) != config.Mode_Local {
+		return warnings
+	}
+
+	consensusClient := cfg.ConsensusClient.Value.(config.ConsensusClient)
+	if cfg.ConsensusCommon.CheckpointSyncProvider.Value.(string) != "" &&
+		cfg.ConsensusCommon.CheckpointVerifyRoot.Value.(string) == "" &&
+		GetCheckpointVerifyRootFlag(consensusClient, defaultCheckpointVerifyRoot) != "" {
+		warnings = append(warnings, fmt.Sprintf("You have Checkpoint Sync enabled without a [%s]. %s recommends verifying the state it downloads against a block root you trust, so a malicious Checkpoint Sync provider can't give it a fake chain.", cfg.ConsensusCommon.CheckpointVerifyRoot.Name, consensusClient))
+	}
+
+	return warnings
+}
+
+// Checks to see if historical state pruning is enabled on the local Consensus client; if so, returns a list of warnings
+// about what it limits
+func (cfg *RocketPoolConfig) CheckHistoricalStatePruning() []string {
+	warnings := []string{}
+	if cfg.IsNativeMode || cfg.ConsensusClientMode.Value.(config.Mode) != config.Mode_Local {
+		return warnings
+	}
+	if cfg.ConsensusCommon.PruneHistoricalStates.Value != true {
+		return warnings
+	}
+
+	consensusClient := cfg.ConsensusClient.Value.(config.ConsensusClient)
+	if GetPruneHistoricalStatesFlag(consensusClient) == "" {
+		warnings = append(warnings, fmt.Sprintf("You have [%s] enabled, but %s doesn't support it so it will be ignored.", cfg.ConsensusCommon.PruneHistoricalStates.Name, consensusClient))
+		return warnings
+	}
+
+	if consensusClient == config.ConsensusClient_Teku && cfg.Teku.ArchiveMode.Value == true {
+		warnings = append(warnings, fmt.Sprintf("You have both [%s] and Teku's [%s] enabled. Archive mode takes priority, so Teku will not prune its historical states.", cfg.ConsensusCommon.PruneHistoricalStates.Name, cfg.Teku.ArchiveMode.Name))
+		return warnings
+	}
+
+	warnings = append(warnings, fmt.Sprintf("You have [%s] enabled. Your Consensus client won't be able to answer queries about the state of the chain at older blocks, so you won't be able to generate Merkle rewards trees for past intervals with it.", cfg.ConsensusCommon.PruneHistoricalStates.Name))
+	return warnings
+}
+
+// Checks to see if any of the external client URLs point to the loopback address while in Docker mode, which won't work because
+// it refers to the container itself instead of the host machine; if so, returns a list of warnings
+func (cfg *RocketPoolConfig) CheckLocalhostUrls() []string {
+	warnings := []string{}
+	if cfg.IsNativeMode {
+		return warnings
+	}
+
+	for _, param := range cfg.getActiveExternalUrlParameters() {
+		urlString, ok := param.param.Value.(string)
+		if !ok || urlString == "" {
+			continue
+		}
+		parsedUrl, err := url.Parse(urlString)
+		if err != nil {
+			continue
+		}
+		hostname := parsedUrl.Hostname()
+		ip := net.ParseIP(hostname)
+		if hostname == "localhost" || (ip != nil && ip.IsLoopback()) {
+			warnings = append(warnings, fmt.Sprintf("[%s] is set to %s, which will not work in Docker mode because `%s` refers to the Docker container itself rather than your machine. Please use your machine's LAN IP address instead (or the Docker gateway IP, which is usually 172.17.0.1).", param.name, urlString, hostname))
+		}
+	}
+
+	return warnings
+}
+
+// Get all of the URL parameters for external services that are currently in use
+func (cfg *RocketPoolConfig) getActiveExternalUrlParameters() []namedParameter {
+	params := []namedParameter{}
+
+	// External EC
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_External {
+		params = append(params,
+			namedParameter{cfg.ExternalExecution.Title + " - " + cfg.ExternalExecution.HttpUrl.Name, &cfg.ExternalExecution.HttpUrl},
+			namedParameter{cfg.ExternalExecution.Title + " - " + cfg.ExternalExecution.WsUrl.Name, &cfg.ExternalExecution.WsUrl},
+		)
+	}
+
+	// External CC
+	consensusClient, mode := cfg.GetSelectedConsensusClient()
+	if mode == config.Mode_External {
+		switch consensusClient {
+		case config.ConsensusClient_Lighthouse:
+			params = append(params, namedParameter{cfg.ExternalLighthouse.Title + " - " + cfg.ExternalLighthouse.HttpUrl.Name, &cfg.ExternalLighthouse.HttpUrl})
+		case config.ConsensusClient_Prysm:
+			params = append(params,
+				namedParameter{cfg.ExternalPrysm.Title + " - " + cfg.ExternalPrysm.HttpUrl.Name, &cfg.ExternalPrysm.HttpUrl},
+				namedParameter{cfg.ExternalPrysm.Title + " - " + cfg.ExternalPrysm.JsonRpcUrl.Name, &cfg.ExternalPrysm.JsonRpcUrl},
+			)
+		case config.ConsensusClient_Teku:
+			params = append(params, namedParameter{cfg.ExternalTeku.Title + " - " + cfg.ExternalTeku.HttpUrl.Name, &cfg.ExternalTeku.HttpUrl})
+		}
+	}
+
+	// Fallback clients
+	if cfg.UseFallbackClients.Value == true {
+		if consensusClient == config.ConsensusClient_Prysm {
+			params = append(params,
+				namedParameter{cfg.FallbackPrysm.Title + " - " + cfg.FallbackPrysm.EcHttpUrl.Name, &cfg.FallbackPrysm.EcHttpUrl},
+				namedParameter{cfg.FallbackPrysm.Title + " - " + cfg.FallbackPrysm.CcHttpUrl.Name, &cfg.FallbackPrysm.CcHttpUrl},
+				namedParameter{cfg.FallbackPrysm.Title + " - " + cfg.FallbackPrysm.JsonRpcUrl.Name, &cfg.FallbackPrysm.JsonRpcUrl},
+			)
+		} else {
+			params = append(params,
+				namedParameter{cfg.FallbackNormal.Title + " - " + cfg.FallbackNormal.EcHttpUrl.Name, &cfg.FallbackNormal.EcHttpUrl},
+				namedParameter{cfg.FallbackNormal.Title + " - " + cfg.FallbackNormal.CcHttpUrl.Name, &cfg.FallbackNormal.CcHttpUrl},
+			)
+		}
+	}
+
+	// Fallback Validator client
+	if cfg.UseFallbackValidator.Value == true {
+		params = append(params, namedParameter{cfg.FallbackValidator.Title + " - " + cfg.FallbackValidator.ApiUrl.Name, &cfg.FallbackValidator.ApiUrl})
+	}
+
+	// External MEV-Boost
+	if cfg.EnableMevBoost.Value == true && cfg.MevBoost.Mode.Value == config.Mode_External {
+		params = append(params, namedParameter{cfg.MevBoost.Title + " - " + cfg.MevBoost.ExternalUrl.Name, &cfg.MevBoost.ExternalUrl})
+	}
+
+	return params
+}
+
+// Checks the sizes of the environment variables that will be passed to the containers against the limits most systems
+// impose on them; if any are too large, returns a list of issues describing the problem
+func (cfg *RocketPoolConfig) CheckEnvironmentSizeLimits() []config.Issue {
+	issues := []config.Issue{}
+	if cfg.IsNativeMode {
+		return issues
+	}
+
+	envVars := cfg.GenerateEnvironmentVariables()
+	names := make([]string, 0, len(envVars))
+	for name := range envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	totalSize := 0
+	for _, name := range names {
+		// Each variable is stored as a null-terminated NAME=VALUE string
+		size := len(name) + len(envVars[name]) + 2
+		totalSize += size
+		if size > maxEnvVarSize {
+			issues = append(issues, config.Issue{
+				Severity: config.IssueSeverity_Error,
+				Message:  fmt.Sprintf("The value of %s is %d bytes long, which is larger than the %d byte limit for a single environment variable. Please shorten the setting it comes from.", name, size, maxEnvVarSize),
+			})
+		} else if size*100 > maxEnvVarSize*envSizeWarningThreshold {
+			issues = append(issues, config.Issue{
+				Severity: config.IssueSeverity_Warning,
+				Message:  fmt.Sprintf("The value of %s is %d bytes long, which is close to the %d byte limit for a single environment variable.", name, size, maxEnvVarSize),
+			})
+		}
+	}
+
+	if totalSize > maxEnvSize {
+		issues = append(issues, config.Issue{
+			Severity: config.IssueSeverity_Error,
+			Message:  fmt.Sprintf("The environment variables for your containers take up %d bytes, which is larger than the %d byte limit most systems allow. Please shorten your additional flags or other long settings.", totalSize, maxEnvSize),
+		})
+	} else if totalSize*100 > maxEnvSize*envSizeWarningThreshold {
+		issues = append(issues, config.Issue{
+			Severity: config.IssueSeverity_Warning,
+			Message:  fmt.Sprintf("The environment variables for your containers take up %d bytes, which is close to the %d byte limit most systems allow.", totalSize, maxEnvSize),
+		})
+	}
+
+	return issues
+}
+
+// Estimates the total amount of RAM needed by the selected clients and services, and compares it to the amount of RAM
+// available on the system; if the estimate is too high, returns a list of issues describing the problem
+func (cfg *RocketPoolConfig) ValidateTotalMemory() []config.Issue {
+	issues := []config.Issue{}
+	if cfg.IsNativeMode {
+		return issues
+	}
+
+	// Skip the check if the system RAM couldn't be determined
+	availableMemory := totalMemory() / 1024 / 1024
+	if availableMemory == 0 {
+		return issues
+	}
+
+	// Add up the estimated memory footprints, in MB
+	requiredMemory := smartnodeMemoryEstimate
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		switch cfg.ExecutionClient.Value.(config.ExecutionClient) {
+		case config.ExecutionClient_Geth:
+			requiredMemory += cfg.Geth.CacheSize.Value.(uint64) + gethMemoryOverhead
+		case config.ExecutionClient_Nethermind:
+			requiredMemory += cfg.Nethermind.CacheSize.Value.(uint64) + cfg.Nethermind.PruneMemSize.Value.(uint64) + nethermindMemoryOverhead
+		case config.ExecutionClient_Besu:
+			requiredMemory += getJvmMemoryEstimate(cfg.Besu.JvmHeapSize.Value.(uint64), besuDefaultHeapEstimate)
+		case config.ExecutionClient_Erigon:
+			requiredMemory += erigonMemoryEstimate
+		}
+	}
+
+	consensusClient, mode := cfg.GetSelectedConsensusClient()
+	if mode == config.Mode_Local {
+		switch consensusClient {
+		case config.ConsensusClient_Lighthouse:
+			requiredMemory += lighthouseMemoryEstimate
+		case config.ConsensusClient_Nimbus:
+			requiredMemory += nimbusMemoryEstimate
+		case config.ConsensusClient_Prysm:
+			requiredMemory += prysmMemoryEstimate
+		case config.ConsensusClient_Teku:
+			requiredMemory += getJvmMemoryEstimate(cfg.Teku.JvmHeapSize.Value.(uint64), tekuDefaultHeapEstimate)
+		case config.ConsensusClient_Lodestar:
+			requiredMemory += lodestarMemoryEstimate
+		}
+	}
+	requiredMemory += validatorClientMemoryEstimate
+
+	if cfg.EnableMetrics.Value == true {
+		requiredMemory += monitoringMemoryEstimate
+	}
+	if cfg.EnableMevBoost.Value == true && cfg.MevBoost.Mode.Value == config.Mode_Local {
+		requiredMemory += mevBoostMemoryEstimate
+	}
+
+	// Compare against the system RAM
+	if requiredMemory > availableMemory {
+		issues = append(issues, config.Issue{
+			Severity: config.IssueSeverity_Error,
+			Message:  fmt.Sprintf("Your selected clients and services are expected to use about %d MB of RAM, but your system only has %d MB. Please choose lighter clients, reduce their cache sizes, or disable some of the optional services.", requiredMemory, availableMemory),
+		})
+	} else if requiredMemory*100 > availableMemory*memoryWarningThreshold {
+		issues = append(issues, config.Issue{
+			Severity: config.IssueSeverity_Warning,
+			Message:  fmt.Sprintf("Your selected clients and services are expected to use about %d MB of RAM, which is more than %d%% of your system's %d MB. Your system may run out of memory during periods of heavy load.", requiredMemory, memoryWarningThreshold, availableMemory),
+		})
+	}
+
+	return issues
+}
+
+// Get the estimated memory footprint of a Java-based client, in MB; a heap size of 0 means the client picks its own
+func getJvmMemoryEstimate(heapSize uint64, defaultHeapEstimate uint64) uint64 {
+	if heapSize == 0 {
+		heapSize = defaultHeapEstimate
+	}
+	return heapSize + jvmMemoryOverhead
+}
+
+// Checks to see if any of the ports used by the enabled services are assigned to more than one setting; if so, returns a list of errors
+func (cfg *RocketPoolConfig) CheckPortConflicts() []string {
+	errors := []string{}
+	if cfg.IsNativeMode {
+		return errors
+	}
+
+	portOwners := map[uint16]string{}
+	for _, portParam := range cfg.getActivePortParameters() {
+		port, ok := portParam.param.Value.(uint16)
+		if !ok {
+			continue
+		}
+		owner, exists := portOwners[port]
+		if exists {
+			errors = append(errors, fmt.Sprintf("Port %d is used by both [%s] and [%s]. Please assign a unique port to each of them.", port, owner, portParam.name))
+			continue
+		}
+		portOwners[port] = portParam.name
+	}
+
+	return errors
+}
+
+// Get all of the ports that will be exposed outside of Docker, which are the ones that need to be opened in a firewall.
+// P2P ports are always exposed; the API ports are only exposed if their corresponding toggle is enabled.
+func (cfg *RocketPoolConfig) ExposedPorts() []config.ExposedPort {
+	ports := []config.ExposedPort{}
+	if cfg.IsNativeMode {
+		return ports
+	}
+
+	addPort := func(param *config.Parameter, protocols ...config.PortProtocol) {
+		port, ok := param.Value.(uint16)
+		if !ok {
+			return
+		}
+		for _, protocol := range protocols {
+			ports = append(ports, config.ExposedPort{
+				Port:      port,
+				Protocol:  protocol,
+				Parameter: param,
+			})
+		}
+	}
+
+	// EC ports
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		addPort(&cfg.ExecutionCommon.P2pPort, config.PortProtocol_Tcp, config.PortProtocol_Udp)
+		if cfg.ExecutionCommon.OpenRpcPorts.Value == true {
+			addPort(&cfg.ExecutionCommon.HttpPort, config.PortProtocol_Tcp)
+			if cfg.getEcWsPort() != &cfg.ExecutionCommon.HttpPort {
+				addPort(&cfg.ExecutionCommon.WsPort, config.PortProtocol_Tcp)
+			}
+		}
+	}
+
+	// CC ports
+	if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
+		addPort(&cfg.ConsensusCommon.P2pPort, config.PortProtocol_Tcp, config.PortProtocol_Udp)
+		if cfg.ConsensusCommon.OpenApiPort.Value == true {
+			addPort(&cfg.ConsensusCommon.ApiPort, config.PortProtocol_Tcp)
+		}
+		if cfg.ConsensusClient.Value.(config.ConsensusClient) == config.ConsensusClient_Prysm && cfg.Prysm.OpenRpcPort.Value == true {
+			addPort(&cfg.Prysm.RpcPort, config.PortProtocol_Tcp)
+		}
+	}
+
+	// Metrics ports
+	if cfg.EnableMetrics.Value == true {
+		addPort(&cfg.Grafana.Port, config.PortProtocol_Tcp)
+		if cfg.Prometheus.OpenPort.Value == true {
+			addPort(&cfg.Prometheus.Port, config.PortProtocol_Tcp)
+		}
+	}
+
+	// MEV-Boost port
+	if cfg.EnableMevBoost.Value == true && cfg.MevBoost.Mode.Value == config.Mode_Local && cfg.MevBoost.OpenRpcPort.Value == true {
+		addPort(&cfg.MevBoost.Port, config.PortProtocol_Tcp)
+	}
+
+	return ports
+}
+
+// Get the recommended values of the port toggles for the given deployment mode ("solo" or "shared"), keyed by their settable
+// paths (such as `prysm.openRpcPort`). Solo nodes don't need to expose anything beyond their P2P ports; nodes that share
+// their clients with other machines on the LAN need their RPC ports exposed. Unknown modes have no recommendations.
+func RecommendedOpenPorts(mode string) map[string]bool {
+	var openRpc bool
+	switch config.DeploymentType(mode) {
+	case config.DeploymentType_Solo:
+		openRpc = false
+	case config.DeploymentType_SharedLan:
+		openRpc = true
+	default:
+		return map[string]bool{}
+	}
+
+	cfg := NewRocketPoolConfig("", false)
+	return map[string]bool{
+		cfg.getPathForParameter(&cfg.ExecutionCommon.OpenRpcPorts): openRpc,
+		cfg.getPathForParameter(&cfg.ConsensusCommon.OpenApiPort):  openRpc,
+		cfg.getPathForParameter(&cfg.Prysm.OpenRpcPort):            openRpc,
+		cfg.getPathForParameter(&cfg.Prometheus.OpenPort):          false,
+		cfg.getPathForParameter(&cfg.MevBoost.OpenRpcPort):         false,
+	}
+}
+
+// Get the port parameter the local Execution client serves its Websocket API on.
+// Some clients (such as Erigon) serve it on the HTTP API port instead of a separate one.
+func (cfg *RocketPoolConfig) getEcWsPort() *config.Parameter {
+	if cfg.ExecutionClient.Value.(config.ExecutionClient) == config.ExecutionClient_Erigon {
+		return &cfg.ExecutionCommon.HttpPort
+	}
+	return &cfg.ExecutionCommon.WsPort
+}
+
+// A parameter along with the name of the section it belongs to
+type namedParameter struct {
+	name  string
+	param *config.Parameter
+}
+
+// Get all of the port parameters that are used by the services that are currently enabled
+func (cfg *RocketPoolConfig) getActivePortParameters() []namedParameter {
+	params := []namedParameter{
+		{cfg.Smartnode.Title + " - " + cfg.Smartnode.ApiPort.Name, &cfg.Smartnode.ApiPort},
+	}
+
+	// EC ports
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
+		ecPorts := []*config.Parameter{
+			&cfg.ExecutionCommon.HttpPort,
+			&cfg.ExecutionCommon.EnginePort,
+			&cfg.ExecutionCommon.P2pPort,
+		}
+		if cfg.getEcWsPort() != &cfg.ExecutionCommon.HttpPort {
+			ecPorts = append(ecPorts, &cfg.ExecutionCommon.WsPort)
+		}
+		for _, param := range ecPorts {
+			params = append(params, namedParameter{cfg.ExecutionCommon.Title + " - " + param.Name, param})
+		}
+	}
+
+	// CC ports
+	if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
+		params = append(params,
+			namedParameter{cfg.ConsensusCommon.Title + " - " + cfg.ConsensusCommon.P2pPort.Name, &cfg.ConsensusCommon.P2pPort},
+			namedParameter{cfg.ConsensusCommon.Title + " - " + cfg.ConsensusCommon.ApiPort.Name, &cfg.ConsensusCommon.ApiPort},
+		)
+		if cfg.ConsensusClient.Value.(config.ConsensusClient) == config.ConsensusClient_Prysm {
+			params = append(params, namedParameter{cfg.Prysm.Title + " - " + cfg.Prysm.RpcPort.Name, &cfg.Prysm.RpcPort})
+		}
+	}
+
+	// Metrics ports
+	if cfg.EnableMetrics.Value == true {
+		for _, param := range []*config.Parameter{
+			&cfg.EcMetricsPort,
+			&cfg.BnMetricsPort,
+			&cfg.VcMetricsPort,
+			&cfg.NodeMetricsPort,
+			&cfg.ExporterMetricsPort,
+			&cfg.WatchtowerMetricsPort,
+		} {
+			params = append(params, namedParameter{cfg.Title + " - " + param.Name, param})
+		}
+		params = append(params,
+			namedParameter{cfg.Prometheus.Title + " - " + cfg.Prometheus.Port.Name, &cfg.Prometheus.Port},
+			namedParameter{cfg.Grafana.Title + " - " + cfg.Grafana.Port.Name, &cfg.Grafana.Port},
+		)
+	}
+
+	// MEV-Boost port
+	if cfg.EnableMevBoost.Value == true && cfg.MevBoost.Mode.Value == config.Mode_Local {
+		params = append(params, namedParameter{cfg.MevBoost.Title + " - " + cfg.MevBoost.Port.Name, &cfg.MevBoost.Port})
+	}
+
+	return params
+}
+
 // Applies all of the defaults to all of the settings that have them defined
 func (cfg *RocketPoolConfig) applyAllDefaults() error {
 	for _, param := range cfg.GetParameters() {
@@ -0,0 +1,618 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Creates a Docker-mode config on Mainnet with locally-managed clients and every setting at its default.
+// The Execution client mode doesn't have a default since the wizard makes the user pick it, which stops
+// NewRocketPoolConfig from applying the rest of them, so they're applied here.
+func newTestConfig() *RocketPoolConfig {
+	cfg := NewRocketPoolConfig("/tmp/rocketpool", false)
+	network := cfg.Smartnode.Network.Value.(config.Network)
+	for _, param := range cfg.GetParameters() {
+		param.SetToDefault(network)
+	}
+	for _, subconfig := range cfg.GetSubconfigs() {
+		for _, param := range subconfig.GetParameters() {
+			param.SetToDefault(network)
+		}
+	}
+	cfg.ExecutionClientMode.Value = config.Mode_Local
+	cfg.ConsensusClientMode.Value = config.Mode_Local
+	return cfg
+}
+
+func TestApiPortDefault(t *testing.T) {
+	cfg := newTestConfig()
+	if cfg.Smartnode.ApiPort.Value != defaultApiPort {
+		t.Errorf("expected the API port to default to %d, got %v", defaultApiPort, cfg.Smartnode.ApiPort.Value)
+	}
+	if err := cfg.Smartnode.ApiPort.Validate(cfg.Smartnode.ApiPort.Value); err != nil {
+		t.Errorf("expected the default API port to be valid, got error: %s", err.Error())
+	}
+	if conflicts := cfg.CheckPortConflicts(); len(conflicts) != 0 {
+		t.Errorf("expected the default ports not to conflict, got %v", conflicts)
+	}
+}
+
+func TestApiPortConflicts(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(cfg *RocketPoolConfig)
+		conflicts bool
+	}{
+		{
+			name:      "unique port",
+			setup:     func(cfg *RocketPoolConfig) { cfg.Smartnode.ApiPort.Value = uint16(8081) },
+			conflicts: false,
+		},
+		{
+			name:      "same as the Beacon Node API",
+			setup:     func(cfg *RocketPoolConfig) { cfg.Smartnode.ApiPort.Value = cfg.ConsensusCommon.ApiPort.Value },
+			conflicts: true,
+		},
+		{
+			name:      "same as the Execution client HTTP port",
+			setup:     func(cfg *RocketPoolConfig) { cfg.Smartnode.ApiPort.Value = cfg.ExecutionCommon.HttpPort.Value },
+			conflicts: true,
+		},
+		{
+			name: "same as an external Execution client's port",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ExecutionClientMode.Value = config.Mode_External
+				cfg.Smartnode.ApiPort.Value = cfg.ExecutionCommon.HttpPort.Value
+			},
+			conflicts: false,
+		},
+		{
+			name: "same as a metrics port with metrics enabled",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.EnableMetrics.Value = true
+				cfg.Smartnode.ApiPort.Value = cfg.NodeMetricsPort.Value
+			},
+			conflicts: true,
+		},
+		{
+			name: "same as a metrics port with metrics disabled",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.EnableMetrics.Value = false
+				cfg.Smartnode.ApiPort.Value = cfg.NodeMetricsPort.Value
+			},
+			conflicts: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			test.setup(cfg)
+			conflicts := cfg.CheckPortConflicts()
+			if test.conflicts {
+				if len(conflicts) != 1 {
+					t.Fatalf("expected 1 conflict, got %v", conflicts)
+				}
+				if !strings.Contains(conflicts[0], cfg.Smartnode.ApiPort.Name) {
+					t.Errorf("expected the conflict to mention the %s, got: %s", cfg.Smartnode.ApiPort.Name, conflicts[0])
+				}
+			} else if len(conflicts) != 0 {
+				t.Errorf("expected no conflicts, got %v", conflicts)
+			}
+		})
+	}
+}
+
+func TestExposedPorts(t *testing.T) {
+	type exposure struct {
+		param    func(cfg *RocketPoolConfig) *config.Parameter
+		protocol config.PortProtocol
+	}
+	ecP2pTcp := exposure{func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ExecutionCommon.P2pPort }, config.PortProtocol_Tcp}
+	ecP2pUdp := exposure{func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ExecutionCommon.P2pPort }, config.PortProtocol_Udp}
+	ccP2pTcp := exposure{func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.P2pPort }, config.PortProtocol_Tcp}
+	ccP2pUdp := exposure{func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.P2pPort }, config.PortProtocol_Udp}
+	ecHttp := exposure{func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ExecutionCommon.HttpPort }, config.PortProtocol_Tcp}
+	ccApi := exposure{func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.ConsensusCommon.ApiPort }, config.PortProtocol_Tcp}
+	prysmRpc := exposure{func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.Prysm.RpcPort }, config.PortProtocol_Tcp}
+
+	tests := []struct {
+		name     string
+		setup    func(cfg *RocketPoolConfig)
+		exposed  []exposure
+		internal []exposure
+	}{
+		{
+			name:     "RPC ports closed",
+			setup:    func(cfg *RocketPoolConfig) {},
+			exposed:  []exposure{ecP2pTcp, ecP2pUdp, ccP2pTcp, ccP2pUdp},
+			internal: []exposure{ecHttp, ccApi, prysmRpc},
+		},
+		{
+			name: "RPC ports opened",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ExecutionCommon.OpenRpcPorts.Value = true
+				cfg.ConsensusCommon.OpenApiPort.Value = true
+			},
+			exposed:  []exposure{ecP2pTcp, ecP2pUdp, ccP2pTcp, ccP2pUdp, ecHttp, ccApi},
+			internal: []exposure{prysmRpc},
+		},
+		{
+			name: "Prysm RPC port opened",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ConsensusClient.Value = config.ConsensusClient_Prysm
+				cfg.Prysm.OpenRpcPort.Value = true
+			},
+			exposed:  []exposure{ecP2pTcp, ecP2pUdp, ccP2pTcp, ccP2pUdp, prysmRpc},
+			internal: []exposure{ecHttp, ccApi},
+		},
+		{
+			name: "external clients",
+			setup: func(cfg *RocketPoolConfig) {
+				cfg.ExecutionClientMode.Value = config.Mode_External
+				cfg.ConsensusClientMode.Value = config.Mode_External
+				cfg.ExecutionCommon.OpenRpcPorts.Value = true
+			},
+			exposed:  []exposure{},
+			internal: []exposure{ecP2pTcp, ccP2pTcp, ecHttp},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			test.setup(cfg)
+			ports := cfg.ExposedPorts()
+			isExposed := func(expected exposure) bool {
+				param := expected.param(cfg)
+				for _, port := range ports {
+					if port.Parameter == param && port.Protocol == expected.protocol {
+						if port.Port != param.Value {
+							t.Errorf("expected [%s] to be exposed on port %v, got %d", param.Name, param.Value, port.Port)
+						}
+						return true
+					}
+				}
+				return false
+			}
+
+			for _, expected := range test.exposed {
+				if !isExposed(expected) {
+					t.Errorf("expected [%s] to be exposed over %s", expected.param(cfg).Name, expected.protocol)
+				}
+			}
+			for _, expected := range test.internal {
+				if isExposed(expected) {
+					t.Errorf("expected [%s] not to be exposed over %s", expected.param(cfg).Name, expected.protocol)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckLocalhostUrls(t *testing.T) {
+	tests := []struct {
+		name       string
+		nativeMode bool
+		ecUrl      string
+		ccUrl      string
+		warnings   int
+	}{
+		{"LAN addresses in Docker mode", false, "http://192.168.1.10:8545", "http://192.168.1.10:5052", 0},
+		{"localhost in Docker mode", false, "http://localhost:8545", "http://192.168.1.10:5052", 1},
+		{"loopback IPs in Docker mode", false, "http://127.0.0.1:8545", "http://[::1]:5052", 2},
+		{"Docker gateway in Docker mode", false, "http://172.17.0.1:8545", "http://172.17.0.1:5052", 0},
+		{"localhost in Native mode", true, "http://localhost:8545", "http://127.0.0.1:5052", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.IsNativeMode = test.nativeMode
+			cfg.ExecutionClientMode.Value = config.Mode_External
+			cfg.ConsensusClientMode.Value = config.Mode_External
+			cfg.ExternalConsensusClient.Value = config.ConsensusClient_Lighthouse
+			cfg.ExternalExecution.HttpUrl.Value = test.ecUrl
+			cfg.ExternalLighthouse.HttpUrl.Value = test.ccUrl
+
+			warnings := cfg.CheckLocalhostUrls()
+			if len(warnings) != test.warnings {
+				t.Fatalf("expected %d warnings, got %v", test.warnings, warnings)
+			}
+			for _, warning := range warnings {
+				if !strings.Contains(warning, "172.17.0.1") {
+					t.Errorf("expected the warning to suggest the Docker gateway IP, got: %s", warning)
+				}
+			}
+		})
+	}
+}
+
+func TestHashIsRepresentationInsensitive(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.ExecutionCommon.HttpPort.Value = uint16(8555)
+	cfg.EnableMetrics.Value = true
+
+	// The same values with different Go types
+	other := newTestConfig()
+	other.ExecutionCommon.HttpPort.Value = uint64(8555)
+	other.EnableMetrics.Value = true
+	if cfg.Hash() != other.Hash() {
+		t.Error("expected configs with the same values stored as different types to have the same hash")
+	}
+
+	// The same values after a round trip through the settings file
+	loaded := NewRocketPoolConfig("/tmp/rocketpool", false)
+	_, err := loaded.Deserialize(cfg.Serialize(), true)
+	if err != nil {
+		t.Fatalf("error deserializing config: %s", err.Error())
+	}
+	if cfg.Hash() != loaded.Hash() {
+		t.Error("expected a deserialized copy of the config to have the same hash")
+	}
+	if cfg.Hash() != cfg.CreateCopy().Hash() {
+		t.Error("expected a copy of the config to have the same hash")
+	}
+
+	// A different value
+	other.ExecutionCommon.HttpPort.Value = uint16(8556)
+	if cfg.Hash() == other.Hash() {
+		t.Error("expected configs with different values to have different hashes")
+	}
+}
+
+func TestHashIgnoresHardwareDefaults(t *testing.T) {
+	cfg := newTestConfig()
+	other := newTestConfig()
+	other.Geth.CacheSize.Default = map[config.Network]interface{}{config.Network_All: uint64(12345)}
+	other.Geth.CacheSize.Value = uint64(12345)
+	if cfg.Hash() != other.Hash() {
+		t.Error("expected the Geth cache size to be ignored when it matches the hardware-based default")
+	}
+
+	other.Geth.CacheSize.Value = uint64(4096)
+	if cfg.Hash() == other.Hash() {
+		t.Error("expected a custom Geth cache size to change the hash")
+	}
+}
+
+func TestRecommendedOpenPorts(t *testing.T) {
+	cfg := newTestConfig()
+	rpcPorts := []string{
+		cfg.getPathForParameter(&cfg.ExecutionCommon.OpenRpcPorts),
+		cfg.getPathForParameter(&cfg.ConsensusCommon.OpenApiPort),
+		cfg.getPathForParameter(&cfg.Prysm.OpenRpcPort),
+	}
+	tests := []struct {
+		name    string
+		mode    string
+		openRpc bool
+	}{
+		{"solo", "solo", false},
+		{"shared LAN", "shared", true},
+	}
+
+	subconfigs := cfg.GetSubconfigs()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preset := RecommendedOpenPorts(test.mode)
+			for _, key := range rpcPorts {
+				open, exists := preset[key]
+				if !exists {
+					t.Errorf("expected the preset to include %s", key)
+				} else if open != test.openRpc {
+					t.Errorf("expected %s to be %t, got %t", key, test.openRpc, open)
+				}
+			}
+
+			// Every setting in the preset has to be a real boolean parameter
+			for key := range preset {
+				elements := strings.SplitN(key, ".", 2)
+				subconfig, exists := subconfigs[elements[0]]
+				if !exists {
+					t.Errorf("preset setting %s is in an unknown section", key)
+					continue
+				}
+				found := false
+				for _, param := range subconfig.GetParameters() {
+					if param.ID == elements[1] {
+						found = true
+						if param.Type != config.ParameterType_Bool {
+							t.Errorf("preset setting %s isn't a boolean parameter", key)
+						}
+					}
+				}
+				if !found {
+					t.Errorf("preset setting %s doesn't match any parameter", key)
+				}
+			}
+		})
+	}
+
+	if preset := RecommendedOpenPorts("cloud"); len(preset) != 0 {
+		t.Errorf("expected no preset for an unknown deployment type, got %v", preset)
+	}
+}
+
+func TestValidateTotalMemory(t *testing.T) {
+	const gigabyte uint64 = 1024 * 1024 * 1024
+	tests := []struct {
+		name       string
+		systemRam  uint64
+		gethCache  uint64
+		tekuHeap   uint64
+		ecMode     config.Mode
+		severities []config.IssueSeverity
+	}{
+		{"high Geth cache and Teku heap on 16 GB", 16 * gigabyte, 8192, 6144, config.Mode_Local, []config.IssueSeverity{config.IssueSeverity_Error}},
+		{"nearly full 16 GB", 16 * gigabyte, 6144, 5120, config.Mode_Local, []config.IssueSeverity{config.IssueSeverity_Warning}},
+		{"moderate settings on 16 GB", 16 * gigabyte, 2048, 2048, config.Mode_Local, []config.IssueSeverity{}},
+		{"high settings on 32 GB", 32 * gigabyte, 8192, 6144, config.Mode_Local, []config.IssueSeverity{}},
+		{"external Execution client on 16 GB", 16 * gigabyte, 8192, 6144, config.Mode_External, []config.IssueSeverity{}},
+		{"unknown system RAM", 0, 8192, 6144, config.Mode_Local, []config.IssueSeverity{}},
+	}
+
+	defer func(original func() uint64) { totalMemory = original }(totalMemory)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			systemRam := test.systemRam
+			totalMemory = func() uint64 { return systemRam }
+
+			cfg := newTestConfig()
+			cfg.ExecutionClientMode.Value = test.ecMode
+			cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+			cfg.ConsensusClient.Value = config.ConsensusClient_Teku
+			cfg.Geth.CacheSize.Value = test.gethCache
+			cfg.Teku.JvmHeapSize.Value = test.tekuHeap
+			cfg.UseFallbackValidator.Value = false
+			cfg.EnableMetrics.Value = false
+			cfg.EnableMevBoost.Value = false
+
+			issues := cfg.ValidateTotalMemory()
+			if len(issues) != len(test.severities) {
+				t.Fatalf("expected %d issues, got %v", len(test.severities), issues)
+			}
+			for i, issue := range issues {
+				if issue.Severity != test.severities[i] {
+					t.Errorf("expected issue %d to have severity %v, got %v: %s", i, test.severities[i], issue.Severity, issue.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestChangeNetwork(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.ExecutionCommon.HttpPort.Value = uint16(8555)
+	cfg.ConsensusCommon.P2pPort.Value = uint16(9555)
+	cfg.Geth.CacheSize.Value = uint64(4096)
+	cfg.Smartnode.PriorityFee.Value = float64(3)
+	cfg.Lighthouse.ContainerTag.Value = "sigp/lighthouse:custom"
+
+	mainnetStorageAddress := cfg.Smartnode.GetStorageAddress()
+	mainnetRplAddress := cfg.Smartnode.GetRplTokenAddress()
+	changes := cfg.ChangeNetwork(config.Network_Prater)
+
+	// Network-specific settings and addresses should switch to Prater's
+	if cfg.Smartnode.Network.Value != config.Network_Prater {
+		t.Fatalf("expected the network to be Prater, got %v", cfg.Smartnode.Network.Value)
+	}
+	if cfg.Smartnode.GetChainID() != 5 {
+		t.Errorf("expected the chain ID to be 5, got %d", cfg.Smartnode.GetChainID())
+	}
+	if cfg.Smartnode.GetStorageAddress() == mainnetStorageAddress {
+		t.Errorf("expected the storage address to change from Mainnet's")
+	}
+	if cfg.Smartnode.GetRplTokenAddress() == mainnetRplAddress {
+		t.Errorf("expected the RPL token address to change from Mainnet's")
+	}
+	praterTag, _ := cfg.Lighthouse.ContainerTag.GetDefault(config.Network_Prater)
+	if cfg.Lighthouse.ContainerTag.Value != praterTag {
+		t.Errorf("expected the Lighthouse container tag to be reset to %v, got %v", praterTag, cfg.Lighthouse.ContainerTag.Value)
+	}
+	praterTag, _ = cfg.Nimbus.ContainerTag.GetDefault(config.Network_Prater)
+	if cfg.Nimbus.ContainerTag.Value != praterTag {
+		t.Errorf("expected the Nimbus container tag to be %v, got %v", praterTag, cfg.Nimbus.ContainerTag.Value)
+	}
+
+	// The customized network-specific setting should be reported
+	found := false
+	for _, change := range changes {
+		if strings.Contains(change, "sigp/lighthouse:custom") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected the reset Lighthouse container tag to be reported, got %v", changes)
+	}
+
+	// Network-agnostic settings should be left alone
+	if cfg.ExecutionCommon.HttpPort.Value != uint16(8555) {
+		t.Errorf("expected the EC HTTP port to persist, got %v", cfg.ExecutionCommon.HttpPort.Value)
+	}
+	if cfg.ConsensusCommon.P2pPort.Value != uint16(9555) {
+		t.Errorf("expected the CC P2P port to persist, got %v", cfg.ConsensusCommon.P2pPort.Value)
+	}
+	if cfg.Geth.CacheSize.Value != uint64(4096) {
+		t.Errorf("expected the Geth cache size to persist, got %v", cfg.Geth.CacheSize.Value)
+	}
+	if cfg.Smartnode.PriorityFee.Value != float64(3) {
+		t.Errorf("expected the priority fee to persist, got %v", cfg.Smartnode.PriorityFee.Value)
+	}
+	maxFeeDefault, _ := cfg.Smartnode.ManualMaxFee.GetDefault(config.Network_Prater)
+	if cfg.Smartnode.ManualMaxFee.Value != maxFeeDefault {
+		t.Errorf("expected the max fee to be Prater's default of %v, got %v", maxFeeDefault, cfg.Smartnode.ManualMaxFee.Value)
+	}
+
+	// Switching to the same network shouldn't change anything
+	if changes := cfg.ChangeNetwork(config.Network_Prater); len(changes) != 0 {
+		t.Errorf("expected no changes when switching to the same network, got %v", changes)
+	}
+}
+
+func TestDeserializeUnrecognizedSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		extraKeys  map[string]map[string]string
+		strictMode bool
+		valid      bool
+		warning    string
+	}{
+		{"clean lenient", nil, false, true, ""},
+		{"clean strict", nil, true, true, ""},
+		{"unknown setting lenient", map[string]map[string]string{"smartnode": {"notARealSetting": "1"}}, false, true, "smartnode.notARealSetting"},
+		{"unknown setting strict", map[string]map[string]string{"smartnode": {"notARealSetting": "1"}}, true, false, ""},
+		{"unknown section lenient", map[string]map[string]string{"notARealSection": {"key": "value"}}, false, true, "notARealSection"},
+		{"unknown section strict", map[string]map[string]string{"notARealSection": {"key": "value"}}, true, false, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			masterMap := newTestConfig().Serialize()
+			for section, keys := range test.extraKeys {
+				if _, exists := masterMap[section]; !exists {
+					masterMap[section] = map[string]string{}
+				}
+				for key, value := range keys {
+					masterMap[section][key] = value
+				}
+			}
+
+			cfg := NewRocketPoolConfig("/tmp/rocketpool", false)
+			issues, err := cfg.Deserialize(masterMap, test.strictMode)
+			if !test.valid {
+				if err == nil {
+					t.Fatal("expected the unrecognized settings to be rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if test.warning == "" {
+				if len(issues) != 0 {
+					t.Errorf("expected no issues, got %v", issues)
+				}
+				return
+			}
+			if len(issues) != 1 {
+				t.Fatalf("expected 1 issue, got %v", issues)
+			}
+			if issues[0].Severity != config.IssueSeverity_Warning {
+				t.Errorf("expected a warning, got severity %v", issues[0].Severity)
+			}
+			if !strings.Contains(issues[0].Message, test.warning) {
+				t.Errorf("expected the warning to mention %s, got: %s", test.warning, issues[0].Message)
+			}
+		})
+	}
+}
+
+func TestGenerateFeeRecipientFile(t *testing.T) {
+	feeRecipient := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+	tests := []struct {
+		name       string
+		nativeMode bool
+		client     config.ConsensusClient
+		expected   string
+	}{
+		{"Lighthouse", false, config.ConsensusClient_Lighthouse, feeRecipient.Hex()},
+		{"Nimbus", false, config.ConsensusClient_Nimbus, feeRecipient.Hex()},
+		{"Prysm", false, config.ConsensusClient_Prysm, feeRecipient.Hex()},
+		{"Teku", false, config.ConsensusClient_Teku, feeRecipient.Hex()},
+		{"Native mode", true, config.ConsensusClient_Lighthouse, FeeRecipientEnvVar + "=" + feeRecipient.Hex()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.IsNativeMode = test.nativeMode
+			cfg.ConsensusClient.Value = test.client
+
+			contents, err := cfg.GenerateFeeRecipientFile(feeRecipient)
+			if err != nil {
+				t.Fatalf("error generating fee recipient file: %s", err.Error())
+			}
+			if string(contents) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, string(contents))
+			}
+		})
+	}
+
+	if _, err := newTestConfig().GenerateFeeRecipientFile(common.Address{}); err == nil {
+		t.Error("expected the zero address to be rejected")
+	}
+}
+
+func TestCheckEnvironmentSizeLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		flagsSize  int
+		nativeMode bool
+		severities []config.IssueSeverity
+	}{
+		{"short flags", 100, false, []config.IssueSeverity{}},
+		{"flags near the limit", 110000, false, []config.IssueSeverity{config.IssueSeverity_Warning}},
+		{"oversized flags", 200000, false, []config.IssueSeverity{config.IssueSeverity_Error}},
+		{"oversized flags in Native mode", 200000, true, []config.IssueSeverity{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.IsNativeMode = test.nativeMode
+			cfg.ExecutionClient.Value = config.ExecutionClient_Geth
+			cfg.Geth.AdditionalFlags.Value = strings.Repeat("a", test.flagsSize)
+
+			issues := cfg.CheckEnvironmentSizeLimits()
+			if len(issues) != len(test.severities) {
+				t.Fatalf("expected %d issues, got %v", len(test.severities), issues)
+			}
+			for i, issue := range issues {
+				if issue.Severity != test.severities[i] {
+					t.Errorf("expected issue %d to have severity %v, got %v: %s", i, test.severities[i], issue.Severity, issue.Message)
+				}
+				if !strings.Contains(issue.Message, "EC_ADDITIONAL_FLAGS") {
+					t.Errorf("expected the issue to name EC_ADDITIONAL_FLAGS, got: %s", issue.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestSuggestDefaultReverts(t *testing.T) {
+	const gigabyte uint64 = 1024 * 1024 * 1024
+	defer func(original func() uint64) { totalMemory = original }(totalMemory)
+	totalMemory = func() uint64 { return 16 * gigabyte }
+
+	tests := []struct {
+		name      string
+		gethCache uint64
+		suggested bool
+	}{
+		{"default for the current 16 GB", 4096, false},
+		{"default from an old 24 GB system", 8192, true},
+		{"default from an old 8 GB system", 256, true},
+		{"custom value", 5000, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Geth.CacheSize.Value = test.gethCache
+
+			suggestions := cfg.SuggestDefaultReverts()
+			if test.suggested {
+				if len(suggestions) != 1 || suggestions[0] != &cfg.Geth.CacheSize {
+					t.Errorf("expected the Geth cache to be suggested for a revert, got %v", suggestions)
+				}
+			} else if len(suggestions) != 0 {
+				t.Errorf("expected no suggestions, got %v", suggestions)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Get a flat list of every parameter that can be set, identified by its section and ID (such as `geth.httpPort`), along with
+// its type and allowed values (for choice parameters). This is intended for shell completion.
+func (cfg *RocketPoolConfig) ListSettablePaths() []config.SettablePath {
+	paths := []config.SettablePath{}
+	for _, param := range cfg.GetParameters() {
+		paths = append(paths, getSettablePath(rootConfigName, param))
+	}
+	for name, subconfig := range cfg.GetSubconfigs() {
+		for _, param := range subconfig.GetParameters() {
+			paths = append(paths, getSettablePath(name, param))
+		}
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].Path < paths[j].Path
+	})
+	return paths
+}
+
+// Get the settable path for a parameter in the given section
+func getSettablePath(section string, param *config.Parameter) config.SettablePath {
+	choices := []string{}
+	for _, option := range param.Options {
+		choices = append(choices, fmt.Sprint(option.Value))
+	}
+	return config.SettablePath{
+		Path:    fmt.Sprintf("%s.%s", section, param.ID),
+		Type:    param.Type,
+		Choices: choices,
+	}
+}
+
+// Applies a batch of `section.id=value` settings to the config, such as `geth.maxPeers=25`.
+// Every setting is parsed and validated before any are applied, so if one of them is invalid, the config is left unchanged.
+func (cfg *RocketPoolConfig) ApplyKeyValues(pairs []string) error {
+	network := cfg.Smartnode.Network.Value.(config.Network)
+
+	newValues := map[*config.Parameter]interface{}{}
+	for _, pair := range pairs {
+		elements := strings.SplitN(pair, "=", 2)
+		if len(elements) != 2 {
+			return fmt.Errorf("invalid setting [%s]: expected a section.id=value pair", pair)
+		}
+		path := strings.TrimSpace(elements[0])
+		param, err := cfg.getParameterByPath(path)
+		if err != nil {
+			return fmt.Errorf("invalid setting [%s]: %w", pair, err)
+		}
+
+		// Parse and check the value on a copy of the parameter so the real one isn't modified yet
+		candidate := *param
+		err = candidate.Deserialize(map[string]string{param.ID: elements[1]}, network)
+		if err != nil {
+			return fmt.Errorf("invalid setting [%s]: %w", pair, err)
+		}
+		err = candidate.ValidateForNetwork(candidate.Value, network)
+		if err != nil {
+			return fmt.Errorf("invalid setting [%s]: %w", pair, err)
+		}
+		if isContainerTagParameter(&candidate) {
+			err = ValidateContainerImage(&candidate)
+			if err != nil {
+				return fmt.Errorf("invalid setting [%s]: %w", pair, err)
+			}
+		}
+		NormalizeContainerImage(&candidate)
+		newValues[param] = candidate.Value
+	}
+
+	// Change the update channel first, so it doesn't replace any container tags that were set explicitly
+	if channel, exists := newValues[&cfg.Smartnode.UpdateChannel]; exists {
+		cfg.ChangeUpdateChannel(channel.(config.UpdateChannel))
+		delete(newValues, &cfg.Smartnode.UpdateChannel)
+	}
+	for param, value := range newValues {
+		param.Value = value
+	}
+	return nil
+}
+
+// Get the parameter with the given settable path (`section.id`)
+func (cfg *RocketPoolConfig) getParameterByPath(path string) (*config.Parameter, error) {
+	elements := strings.SplitN(path, ".", 2)
+	if len(elements) != 2 {
+		return nil, fmt.Errorf("[%s] is not a section.id path", path)
+	}
+	section := elements[0]
+	id := elements[1]
+
+	var params []*config.Parameter
+	if section == rootConfigName {
+		params = cfg.GetParameters()
+	} else {
+		subconfig, exists := cfg.GetSubconfigs()[section]
+		if !exists {
+			return nil, fmt.Errorf("there is no settings section named [%s]", section)
+		}
+		params = subconfig.GetParameters()
+	}
+
+	for _, param := range params {
+		if param.ID == id {
+			return param, nil
+		}
+	}
+	return nil, fmt.Errorf("there is no setting named [%s] in section [%s]", id, section)
+}
+
+// Get the settable path (`section.id`) of one of this config's parameters, or an empty string if it doesn't belong to the config
+func (cfg *RocketPoolConfig) getPathForParameter(target *config.Parameter) string {
+	for _, param := range cfg.GetParameters() {
+		if param == target {
+			return getSettablePath(rootConfigName, param).Path
+		}
+	}
+	for name, subconfig := range cfg.GetSubconfigs() {
+		for _, param := range subconfig.GetParameters() {
+			if param == target {
+				return getSettablePath(name, param).Path
+			}
+		}
+	}
+	return ""
+}
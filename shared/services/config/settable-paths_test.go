@@ -0,0 +1,90 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestListSettablePaths(t *testing.T) {
+	cfg := NewRocketPoolConfig("", false)
+	paths := map[string]config.SettablePath{}
+	for _, path := range cfg.ListSettablePaths() {
+		if _, exists := paths[path.Path]; exists {
+			t.Errorf("expected [%s] to be listed once", path.Path)
+		}
+		paths[path.Path] = path
+	}
+
+	httpPort, exists := paths["executionCommon.httpPort"]
+	if !exists {
+		t.Fatal("expected executionCommon.httpPort to be listed")
+	}
+	if httpPort.Type != config.ParameterType_Uint16 {
+		t.Errorf("expected executionCommon.httpPort to be a %s, got %s", config.ParameterType_Uint16, httpPort.Type)
+	}
+	if len(httpPort.Choices) != 0 {
+		t.Errorf("expected executionCommon.httpPort to have no choices, got %v", httpPort.Choices)
+	}
+
+	executionClient, exists := paths["root.executionClient"]
+	if !exists {
+		t.Fatal("expected root.executionClient to be listed")
+	}
+	if executionClient.Type != config.ParameterType_Choice {
+		t.Errorf("expected root.executionClient to be a %s, got %s", config.ParameterType_Choice, executionClient.Type)
+	}
+	foundGeth := false
+	for _, choice := range executionClient.Choices {
+		if choice == string(config.ExecutionClient_Geth) {
+			foundGeth = true
+		}
+	}
+	if !foundGeth {
+		t.Errorf("expected root.executionClient choices to include %s, got %v", config.ExecutionClient_Geth, executionClient.Choices)
+	}
+}
+
+func TestApplyKeyValues(t *testing.T) {
+	cfg := newTestConfig()
+	err := cfg.ApplyKeyValues([]string{"executionCommon.httpPort=8555", "geth.maxPeers=25", "root.executionClient=nethermind"})
+	if err != nil {
+		t.Fatalf("error applying settings: %s", err.Error())
+	}
+	if cfg.ExecutionCommon.HttpPort.Value != uint16(8555) {
+		t.Errorf("expected the EC HTTP port to be 8555, got %v", cfg.ExecutionCommon.HttpPort.Value)
+	}
+	if cfg.Geth.MaxPeers.Value != uint16(25) {
+		t.Errorf("expected Geth's max peers to be 25, got %v", cfg.Geth.MaxPeers.Value)
+	}
+	if cfg.ExecutionClient.Value != config.ExecutionClient_Nethermind {
+		t.Errorf("expected the Execution client to be %s, got %v", config.ExecutionClient_Nethermind, cfg.ExecutionClient.Value)
+	}
+}
+
+func TestApplyKeyValuesIsAllOrNothing(t *testing.T) {
+	tests := []struct {
+		name    string
+		invalid string
+	}{
+		{"missing value", "geth.maxPeers"},
+		{"not a section.id path", "maxPeers=25"},
+		{"unknown section", "reth.maxPeers=25"},
+		{"unknown setting", "geth.maxPeerz=25"},
+		{"wrong type", "geth.maxPeers=lots"},
+		{"not a choice", "root.executionClient=reth"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			err := cfg.ApplyKeyValues([]string{"executionCommon.httpPort=8555", test.invalid})
+			if err == nil {
+				t.Fatalf("expected [%s] to be rejected", test.invalid)
+			}
+			if cfg.ExecutionCommon.HttpPort.Value != defaultEcHttpPort {
+				t.Errorf("expected the EC HTTP port to be left at %d, got %v", defaultEcHttpPort, cfg.ExecutionCommon.HttpPort.Value)
+			}
+		})
+	}
+}
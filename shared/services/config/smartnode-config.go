@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/shared"
@@ -16,6 +17,7 @@ const (
 	pruneProvisionerTag                string = "rocketpool/eth1-prune-provision:v0.0.1"
 	ecMigratorTag                      string = "rocketpool/ec-migrator:v1.0.0"
 	NetworkID                          string = "network"
+	UpdateChannelID                    string = "updateChannel"
 	ProjectNameID                      string = "projectName"
 	SnapshotID                         string = "rocketpool-dao.eth"
 	RewardsTreeFilenameFormat          string = "rp-rewards-%s-%d.json"
@@ -31,10 +33,20 @@ const (
 	SecondaryRewardsFileUrl            string = "https://ipfs.io/ipfs/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	TekuProposerConfigFilename         string = "rp-teku-proposer-config.json"
 )
 
 // Defaults
 const defaultProjectName string = "rocketpool"
+const defaultApiPort uint16 = 8080
+const defaultScrubSafetyDivider uint64 = 2
+const defaultMinScrubSafetyTime uint64 = 0
+
+// Scrub check limits
+const minScrubSafetyDivider uint64 = 1
+const maxScrubSafetyDivider uint64 = 10
+const minMinScrubSafetyTime uint64 = 0
+const maxMinScrubSafetyTime uint64 = 168
 
 // Configuration for the Smartnode
 type SmartnodeConfig struct {
@@ -59,6 +71,12 @@ type SmartnodeConfig struct {
 	// Which network we're on
 	Network config.Parameter `yaml:"network,omitempty"`
 
+	// The port the API container should serve on
+	ApiPort config.Parameter `yaml:"apiPort,omitempty"`
+
+	// The release channel to pull container images from
+	UpdateChannel config.Parameter `yaml:"updateChannel,omitempty"`
+
 	// Manual max fee override
 	ManualMaxFee config.Parameter `yaml:"manualMaxFee,omitempty"`
 
@@ -77,6 +95,27 @@ type SmartnodeConfig struct {
 	// Token for Oracle DAO members to use when uploading Merkle trees to Web3.Storage
 	Web3StorageApiToken config.Parameter `yaml:"web3StorageApiToken,omitempty"`
 
+	// Path to a custom bundle of trusted certificate authorities
+	CaBundlePath config.Parameter `yaml:"caBundlePath,omitempty"`
+
+	// The fraction of the scrub period after which the watchtower will scrub minipools without deposit information
+	ScrubSafetyDivider config.Parameter `yaml:"scrubSafetyDivider,omitempty"`
+
+	// The minimum time (in hours) before the watchtower will scrub minipools without deposit information
+	MinScrubSafetyTime config.Parameter `yaml:"minScrubSafetyTime,omitempty"`
+
+	// How often to back up the validator keys and slashing protection database
+	BackupSchedule config.Parameter `yaml:"backupSchedule,omitempty"`
+
+	// The folder to store backups in
+	BackupPath config.Parameter `yaml:"backupPath,omitempty"`
+
+	// The largest size a container's log file can grow to before it's rotated
+	LogMaxSize config.Parameter `yaml:"logMaxSize,omitempty"`
+
+	// The number of rotated log files to keep for each container
+	LogMaxFiles config.Parameter `yaml:"logMaxFiles,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -189,6 +228,43 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			Options:              getNetworkOptions(),
 		},
 
+		ApiPort: config.Parameter{
+			ID:                   "apiPort",
+			Name:                 "API Port",
+			Description:          "The port the Smartnode's API container should serve its API on.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultApiPort},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api},
+			EnvironmentVariables: []string{"API_PORT"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		UpdateChannel: config.Parameter{
+			ID:                   UpdateChannelID,
+			Name:                 "Update Channel",
+			Description:          "Select which release channel your client container images should come from. Container tags that are still set to their defaults will switch to the new channel's images.\n\n[orange]WARNING: Beta and Nightly images are not as thoroughly tested as Stable ones. Only use them on Mainnet if you understand the risks.",
+			Type:                 config.ParameterType_Choice,
+			Default:              map[config.Network]interface{}{config.Network_All: config.UpdateChannel_Stable},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1, config.ContainerID_Eth2, config.ContainerID_Validator, config.ContainerID_MevBoost},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+			Options: []config.ParameterOption{{
+				Name:        "Stable",
+				Description: "Use the container images that have been tested and released for your selected network.",
+				Value:       config.UpdateChannel_Stable,
+			}, {
+				Name:        "Beta",
+				Description: "Use the newest release of each client, before it has been tested with and pinned by the Smartnode.",
+				Value:       config.UpdateChannel_Beta,
+			}, {
+				Name:        "Nightly",
+				Description: "Use each client's development builds where they're published, and the newest release otherwise. These are experimental and may be unstable.",
+				Value:       config.UpdateChannel_Nightly,
+			}},
+		},
+
 		ManualMaxFee: config.Parameter{
 			ID:                   "manualMaxFee",
 			Name:                 "Manual Max Fee",
@@ -269,8 +345,76 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			EnvironmentVariables: []string{},
 			CanBeBlank:           true,
 			OverwriteOnUpgrade:   false,
+			Secret:               true,
 		},
 
+		CaBundlePath: config.Parameter{
+			ID:                   "caBundlePath",
+			Name:                 "Custom CA Bundle Path",
+			Description:          "If your network uses a proxy that intercepts TLS connections, enter the path to a PEM file containing the certificate authorities it uses here. It will be mounted into the Smartnode's containers so they trust those certificates.\n\nLeave this blank to use the system's default certificate authorities.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{"CA_BUNDLE_PATH"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ScrubSafetyDivider: config.Parameter{
+			ID:                   "scrubSafetyDivider",
+			Name:                 "Scrub Safety Divider",
+			Description:          fmt.Sprintf("[orange]**For Oracle DAO members only.**\n\n[white]If a prelaunch minipool has no deposit information, the watchtower will vote to scrub it once this fraction of the scrub period has passed (for example, 2 means half of the scrub period). Higher values scrub such minipools sooner.\n\nMust be between %d and %d.", minScrubSafetyDivider, maxScrubSafetyDivider),
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultScrubSafetyDivider},
+			MinValue:             minScrubSafetyDivider,
+			MaxValue:             maxScrubSafetyDivider,
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		MinScrubSafetyTime: config.Parameter{
+			ID:                   "minScrubSafetyTime",
+			Name:                 "Min Scrub Safety Time",
+			Description:          fmt.Sprintf("[orange]**For Oracle DAO members only.**\n\n[white]The minimum amount of time (in hours) that must pass after a minipool enters prelaunch before the watchtower will vote to scrub it for having no deposit information, regardless of the Scrub Safety Divider.\n\nMust be between %d and %d.", minMinScrubSafetyTime, maxMinScrubSafetyTime),
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultMinScrubSafetyTime},
+			MinValue:             minMinScrubSafetyTime,
+			MaxValue:             maxMinScrubSafetyTime,
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		BackupSchedule: config.Parameter{
+			ID:                   "backupSchedule",
+			Name:                 "Backup Schedule",
+			Description:          "How often the Smartnode should back up your validator keys and slashing protection database, as a duration such as `24h` or `168h`. Backups can run at most once per hour.\n\nLeave this blank to disable automatic backups.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		BackupPath: config.Parameter{
+			ID:                   "backupPath",
+			Name:                 "Backup Path",
+			Description:          "The absolute path of the folder to store automatic backups in. This folder must already exist. You may use environment variables in this string.\n\nFor the backups to be useful if your node's disk fails, this should be on a different disk or a network share.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{"ROCKETPOOL_BACKUP_FOLDER"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		LogMaxSize:  generateLogMaxSizeParameter(),
+		LogMaxFiles: generateLogMaxFilesParameter(),
+
 		txWatchUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://etherscan.io/tx",
 			config.Network_Prater:  "https://goerli.etherscan.io/tx",
@@ -399,12 +543,21 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.Network,
 		&cfg.ProjectName,
 		&cfg.DataPath,
+		&cfg.ApiPort,
+		&cfg.UpdateChannel,
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
 		&cfg.MinipoolStakeGasThreshold,
 		&cfg.RewardsTreeMode,
 		&cfg.ArchiveECUrl,
 		&cfg.Web3StorageApiToken,
+		&cfg.CaBundlePath,
+		&cfg.ScrubSafetyDivider,
+		&cfg.MinScrubSafetyTime,
+		&cfg.BackupSchedule,
+		&cfg.BackupPath,
+		&cfg.LogMaxSize,
+		&cfg.LogMaxFiles,
 	}
 }
 
@@ -571,6 +724,11 @@ func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "validators", NativeFeeRecipientFilename)
 }
 
+// Get the path of the Teku proposer config file that carries the builder registration overrides
+func (cfg *SmartnodeConfig) GetTekuProposerConfigFilePath() string {
+	return filepath.Join(DaemonDataPath, "validators", TekuProposerConfigFilename)
+}
+
 func (cfg *SmartnodeConfig) GetLegacyRewardsPoolAddress() common.Address {
 	return common.HexToAddress(cfg.legacyRewardsPoolAddress[cfg.Network.Value.(config.Network)])
 }
@@ -599,6 +757,43 @@ func (cfg *SmartnodeConfig) GetRewardsSubmissionBlockMaps() []uint64 {
 	return cfg.rewardsSubmissionBlockMaps[cfg.Network.Value.(config.Network)]
 }
 
+// Simulates the node's automatic minipool stake transaction over a series of Rapid max fee suggestions (in gwei), returning
+// whether the transaction would be submitted at each point under the current Manual Max Fee and gas threshold settings.
+// This doesn't account for the node ignoring the threshold once the minipool's stake deadline is close.
+func (cfg *SmartnodeConfig) SimulateAutoStakes(feeCurve []float64) []bool {
+	threshold := cfg.MinipoolStakeGasThreshold.Value.(float64)
+	manualMaxFee := cfg.ManualMaxFee.Value.(float64)
+
+	results := make([]bool, len(feeCurve))
+	for i, suggestedFee := range feeCurve {
+		maxFee := suggestedFee
+		if manualMaxFee > 0 {
+			maxFee = manualMaxFee
+		}
+		results[i] = maxFee < threshold
+	}
+	return results
+}
+
+// Get the amount of time after a minipool enters prelaunch that the watchtower will scrub it if it has no deposit information
+func (cfg *SmartnodeConfig) GetScrubSafetyPeriod(scrubPeriod time.Duration) time.Duration {
+	divider, ok := cfg.ScrubSafetyDivider.Value.(uint64)
+	if !ok || divider == 0 {
+		divider = defaultScrubSafetyDivider
+	}
+	minSafetyHours, ok := cfg.MinScrubSafetyTime.Value.(uint64)
+	if !ok {
+		minSafetyHours = defaultMinScrubSafetyTime
+	}
+
+	safetyPeriod := scrubPeriod / time.Duration(divider)
+	minSafetyTime := time.Duration(minSafetyHours) * time.Hour
+	if safetyPeriod < minSafetyTime {
+		safetyPeriod = minSafetyTime
+	}
+	return safetyPeriod
+}
+
 func getNetworkOptions() []config.ParameterOption {
 	options := []config.ParameterOption{
 		{
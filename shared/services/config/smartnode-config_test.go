@@ -0,0 +1,101 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScrubSettingsValidation(t *testing.T) {
+	cfg := NewRocketPoolConfig("", false)
+	tests := []struct {
+		name  string
+		param string
+		value uint64
+		valid bool
+	}{
+		{"divider of 0", "divider", 0, false},
+		{"divider of 1", "divider", 1, true},
+		{"divider of 10", "divider", 10, true},
+		{"divider of 11", "divider", 11, false},
+		{"min time of 0", "time", 0, true},
+		{"min time of 168", "time", 168, true},
+		{"min time of 169", "time", 169, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			param := &cfg.Smartnode.ScrubSafetyDivider
+			if test.param == "time" {
+				param = &cfg.Smartnode.MinScrubSafetyTime
+			}
+			err := param.Validate(test.value)
+			if test.valid && err != nil {
+				t.Errorf("expected %d to be valid, got error: %s", test.value, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %d to be rejected", test.value)
+			}
+		})
+	}
+}
+
+func TestGetScrubSafetyPeriod(t *testing.T) {
+	scrubPeriod := 12 * time.Hour
+	tests := []struct {
+		name     string
+		divider  interface{}
+		minTime  interface{}
+		expected time.Duration
+	}{
+		{"defaults", defaultScrubSafetyDivider, defaultMinScrubSafetyTime, 6 * time.Hour},
+		{"larger divider", uint64(4), uint64(0), 3 * time.Hour},
+		{"min time takes over", uint64(4), uint64(5), 5 * time.Hour},
+		{"unset values", nil, nil, 6 * time.Hour},
+		{"zero divider", uint64(0), uint64(0), 6 * time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := NewRocketPoolConfig("", false)
+			cfg.Smartnode.ScrubSafetyDivider.Value = test.divider
+			cfg.Smartnode.MinScrubSafetyTime.Value = test.minTime
+			period := cfg.Smartnode.GetScrubSafetyPeriod(scrubPeriod)
+			if period != test.expected {
+				t.Errorf("expected a safety period of %s, got %s", test.expected, period)
+			}
+		})
+	}
+}
+
+func TestSimulateAutoStakes(t *testing.T) {
+	feeCurve := []float64{200, 160, 150, 140, 90, 155}
+	tests := []struct {
+		name         string
+		threshold    float64
+		manualMaxFee float64
+		expected     []bool
+	}{
+		{"curve crossing the threshold", 150, 0, []bool{false, false, false, true, true, false}},
+		{"lower threshold", 100, 0, []bool{false, false, false, false, true, false}},
+		{"manual max fee below the threshold", 150, 50, []bool{true, true, true, true, true, true}},
+		{"manual max fee above the threshold", 150, 175, []bool{false, false, false, false, false, false}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.Smartnode.MinipoolStakeGasThreshold.Value = test.threshold
+			cfg.Smartnode.ManualMaxFee.Value = test.manualMaxFee
+			results := cfg.Smartnode.SimulateAutoStakes(feeCurve)
+			if len(results) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, results)
+			}
+			for i := range results {
+				if results[i] != test.expected[i] {
+					t.Errorf("expected %v, got %v", test.expected, results)
+					break
+				}
+			}
+		})
+	}
+}
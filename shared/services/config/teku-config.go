@@ -1,12 +1,13 @@
 package config
 
 import (
-	"github.com/pbnjay/memory"
 	"github.com/rocket-pool/smartnode/shared/types/config"
 )
 
 const (
 	tekuTag             string = "consensys/teku:22.12.0"
+	tekuTagBeta         string = "consensys/teku:latest"
+	tekuTagNightly      string = "consensys/teku:develop"
 	defaultTekuMaxPeers uint16 = 100
 )
 
@@ -26,6 +27,9 @@ type TekuConfig struct {
 	// The archive mode flag
 	ArchiveMode config.Parameter `yaml:"archiveMode,omitempty"`
 
+	// The log level for Teku
+	LogLevel config.Parameter `yaml:"logLevel,omitempty"`
+
 	// The Docker Hub tag for Lighthouse
 	ContainerTag config.Parameter `yaml:"containerTag,omitempty"`
 
@@ -50,7 +54,7 @@ func NewTekuConfig(cfg *RocketPoolConfig) *TekuConfig {
 			Name:                 "JVM Heap Size",
 			Description:          "The max amount of RAM, in MB, that Teku's JVM should limit itself to. Setting this lower will cause Teku to use less RAM, though it will always use more than this limit.\n\nUse 0 for automatic allocation.",
 			Type:                 config.ParameterType_Uint,
-			Default:              map[config.Network]interface{}{config.Network_All: getTekuHeapSize()},
+			Default:              map[config.Network]interface{}{config.Network_All: getTekuHeapSize(getTotalMemoryGB())},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"TEKU_JVM_HEAP_SIZE"},
 			CanBeBlank:           false,
@@ -81,6 +85,8 @@ func NewTekuConfig(cfg *RocketPoolConfig) *TekuConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		LogLevel: generateLogLevelParameter("Teku", []config.ContainerID{config.ContainerID_Eth2, config.ContainerID_Validator}),
+
 		ContainerTag: config.Parameter{
 			ID:                   "containerTag",
 			Name:                 "Container Tag",
@@ -125,6 +131,7 @@ func (cfg *TekuConfig) GetParameters() []*config.Parameter {
 		&cfg.JvmHeapSize,
 		&cfg.MaxPeers,
 		&cfg.ArchiveMode,
+		&cfg.LogLevel,
 		&cfg.ContainerTag,
 		&cfg.AdditionalBnFlags,
 		&cfg.AdditionalVcFlags,
@@ -132,8 +139,7 @@ func (cfg *TekuConfig) GetParameters() []*config.Parameter {
 }
 
 // Get the recommended heap size for Teku
-func getTekuHeapSize() uint64 {
-	totalMemoryGB := memory.TotalMemory() / 1024 / 1024 / 1024
+func getTekuHeapSize(totalMemoryGB uint64) uint64 {
 	if totalMemoryGB < 9 {
 		return 2048
 	}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Check if a parameter holds the tag of a container image
+func isContainerTagParameter(param *config.Parameter) bool {
+	return strings.HasSuffix(strings.ToLower(param.ID), "containertag")
+}
+
+// Get the default container tags for an update channel, keyed by settable path (`section.id`).
+// Beta uses the newest release of each client before the Smartnode has pinned it, and Nightly uses each client's development
+// builds where they're published (falling back to the Beta tag otherwise). Stable, and any container that isn't listed, uses
+// the regular defaults for the selected network.
+func getUpdateChannelTags(channel config.UpdateChannel) map[string]string {
+	betaTags := map[string]string{
+		"geth.containerTag":               gethTagBeta,
+		"nethermind.containerTag":         nethermindTagBeta,
+		"besu.containerTag":               besuTagBeta,
+		"erigon.containerTag":             erigonTagBeta,
+		"lighthouse.containerTag":         getLighthouseTagBeta(),
+		"externalLighthouse.containerTag": getLighthouseTagBeta(),
+		"lodestar.containerTag":           lodestarTagBeta,
+		"nimbus.containerTag":             nimbusTagBeta,
+		"teku.containerTag":               tekuTagBeta,
+		"externalTeku.containerTag":       tekuTagBeta,
+	}
+
+	switch channel {
+	case config.UpdateChannel_Beta:
+		return betaTags
+
+	case config.UpdateChannel_Nightly:
+		nightlyTags := map[string]string{
+			"geth.containerTag":               gethTagNightly,
+			"besu.containerTag":               besuTagNightly,
+			"erigon.containerTag":             erigonTagNightly,
+			"lighthouse.containerTag":         getLighthouseTagNightly(),
+			"externalLighthouse.containerTag": getLighthouseTagNightly(),
+			"lodestar.containerTag":           lodestarTagNightly,
+			"teku.containerTag":               tekuTagNightly,
+			"externalTeku.containerTag":       tekuTagNightly,
+		}
+		for path, tag := range betaTags {
+			if _, exists := nightlyTags[path]; !exists {
+				nightlyTags[path] = tag
+			}
+		}
+		return nightlyTags
+
+	default:
+		return map[string]string{}
+	}
+}
+
+// Sets the defaults of the container tag parameters to the tags for the selected update channel, so everything that uses
+// a parameter's default (such as resetting it, changing networks, or upgrading) picks up the channel
+func (cfg *RocketPoolConfig) applyUpdateChannel() {
+	channel, ok := cfg.Smartnode.UpdateChannel.Value.(config.UpdateChannel)
+	if !ok {
+		channel = config.UpdateChannel_Stable
+	}
+	channelTags := getUpdateChannelTags(channel)
+
+	if cfg.stableTagDefaults == nil {
+		cfg.stableTagDefaults = map[*config.Parameter]map[config.Network]interface{}{}
+	}
+	for name, subconfig := range cfg.GetSubconfigs() {
+		for _, param := range subconfig.GetParameters() {
+			if !isContainerTagParameter(param) {
+				continue
+			}
+
+			// Keep the regular defaults so they can be restored if the channel goes back to Stable
+			stableDefault, exists := cfg.stableTagDefaults[param]
+			if !exists {
+				stableDefault = param.Default
+				cfg.stableTagDefaults[param] = stableDefault
+			}
+
+			tag, exists := channelTags[fmt.Sprintf("%s.%s", name, param.ID)]
+			if exists {
+				param.Default = map[config.Network]interface{}{config.Network_All: tag}
+			} else {
+				param.Default = stableDefault
+			}
+		}
+	}
+}
+
+// Handle an update channel change on all of the container tag parameters. Tags that are still set to their default are
+// switched to the new channel's default; custom tags are left alone.
+func (cfg *RocketPoolConfig) ChangeUpdateChannel(newChannel config.UpdateChannel) {
+	network := cfg.Smartnode.Network.Value.(config.Network)
+
+	// Get the current defaults
+	oldDefaults := map[*config.Parameter]interface{}{}
+	for _, subconfig := range cfg.GetSubconfigs() {
+		for _, param := range subconfig.GetParameters() {
+			if !isContainerTagParameter(param) {
+				continue
+			}
+			oldDefault, err := param.GetDefault(network)
+			if err == nil {
+				oldDefaults[param] = oldDefault
+			}
+		}
+	}
+
+	cfg.Smartnode.UpdateChannel.Value = newChannel
+	cfg.applyUpdateChannel()
+
+	// Move the tags that were on the old defaults to the new ones
+	for param, oldDefault := range oldDefaults {
+		if param.Value != oldDefault {
+			continue
+		}
+		newDefault, err := param.GetDefault(network)
+		if err == nil {
+			param.Value = newDefault
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestUpdateChannelDefaults(t *testing.T) {
+	stable := newTestConfig()
+	beta := newTestConfig()
+	beta.ChangeUpdateChannel(config.UpdateChannel_Beta)
+	nightly := newTestConfig()
+	nightly.ChangeUpdateChannel(config.UpdateChannel_Nightly)
+
+	tests := []struct {
+		name    string
+		param   func(cfg *RocketPoolConfig) *config.Parameter
+		beta    string
+		nightly string
+	}{
+		{"Geth", func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.Geth.ContainerTag }, gethTagBeta, gethTagNightly},
+		{"Teku", func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.Teku.ContainerTag }, tekuTagBeta, tekuTagNightly},
+		{"Nimbus", func(cfg *RocketPoolConfig) *config.Parameter { return &cfg.Nimbus.ContainerTag }, nimbusTagBeta, nimbusTagBeta},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stableTag := test.param(stable).Value
+			if stableTag == test.beta {
+				t.Errorf("expected Stable to use a different tag than Beta, both are %s", test.beta)
+			}
+			if test.param(beta).Value != test.beta {
+				t.Errorf("expected Beta to use %s, got %v", test.beta, test.param(beta).Value)
+			}
+			if test.param(nightly).Value != test.nightly {
+				t.Errorf("expected Nightly to use %s, got %v", test.nightly, test.param(nightly).Value)
+			}
+		})
+	}
+}
+
+func TestChangeUpdateChannel(t *testing.T) {
+	cfg := newTestConfig()
+	stableGethTag := cfg.Geth.ContainerTag.Value
+	cfg.Teku.ContainerTag.Value = "consensys/teku:custom"
+
+	// Custom tags should be left alone
+	cfg.ChangeUpdateChannel(config.UpdateChannel_Beta)
+	if cfg.Geth.ContainerTag.Value != gethTagBeta {
+		t.Errorf("expected the Geth tag to follow the channel to %s, got %v", gethTagBeta, cfg.Geth.ContainerTag.Value)
+	}
+	if cfg.Teku.ContainerTag.Value != "consensys/teku:custom" {
+		t.Errorf("expected the custom Teku tag to be kept, got %v", cfg.Teku.ContainerTag.Value)
+	}
+
+	// The channel should still apply after a round trip through the settings file
+	loaded := NewRocketPoolConfig("/tmp/rocketpool", false)
+	if _, err := loaded.Deserialize(cfg.Serialize(), false); err != nil {
+		t.Fatalf("error deserializing config: %s", err.Error())
+	}
+	defaultTag, err := loaded.Geth.ContainerTag.GetDefault(config.Network_Mainnet)
+	if err != nil {
+		t.Fatalf("error getting the Geth tag's default: %s", err.Error())
+	}
+	if defaultTag != gethTagBeta {
+		t.Errorf("expected the loaded config to default to %s, got %v", gethTagBeta, defaultTag)
+	}
+
+	// Going back to Stable should restore the regular defaults
+	cfg.ChangeUpdateChannel(config.UpdateChannel_Stable)
+	if cfg.Geth.ContainerTag.Value != stableGethTag {
+		t.Errorf("expected the Geth tag to go back to %v, got %v", stableGethTag, cfg.Geth.ContainerTag.Value)
+	}
+}
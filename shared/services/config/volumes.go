@@ -0,0 +1,76 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Constants
+const (
+	secretsFolder           string = "secrets"
+	secretsContainerPath    string = "/secrets"
+	validatorsFolder        string = "validators"
+	validatorsContainerPath string = "/validators"
+	caBundleContainerPath   string = "/etc/ssl/certs/rp-ca-bundle.crt"
+)
+
+// Get the host paths that need to be mounted into the Docker containers for the current configuration.
+// All of the host paths are absolute.
+func (cfg *RocketPoolConfig) RequiredVolumes() []config.VolumeMount {
+	if cfg.IsNativeMode {
+		return []config.VolumeMount{}
+	}
+
+	dataPath := getAbsolutePath(cfg.Smartnode.DataPath.Value.(string))
+	volumes := []config.VolumeMount{
+		{
+			HostPath:      dataPath,
+			ContainerPath: DaemonDataPath,
+			ReadOnly:      false,
+		},
+		{
+			HostPath:      filepath.Join(dataPath, validatorsFolder),
+			ContainerPath: validatorsContainerPath,
+			ReadOnly:      false,
+		},
+		{
+			HostPath:      getAbsolutePath(filepath.Join(cfg.RocketPoolDirectory, secretsFolder)),
+			ContainerPath: secretsContainerPath,
+			ReadOnly:      true,
+		},
+	}
+
+	caBundlePath := cfg.Smartnode.GetCaBundlePath(false)
+	if caBundlePath != "" {
+		volumes = append(volumes, config.VolumeMount{
+			HostPath:      caBundlePath,
+			ContainerPath: caBundleContainerPath,
+			ReadOnly:      true,
+		})
+	}
+
+	if cfg.Smartnode.BackupSchedule.Value.(string) != "" {
+		volumes = append(volumes, config.VolumeMount{
+			HostPath:      cfg.Smartnode.GetBackupFolder(false),
+			ContainerPath: backupContainerPath,
+			ReadOnly:      false,
+		})
+	}
+
+	return volumes
+}
+
+// Expands the home directory in a path and makes it absolute; if either fails, the path is returned as-is
+func getAbsolutePath(path string) string {
+	expandedPath, err := homedir.Expand(path)
+	if err != nil {
+		return path
+	}
+	absolutePath, err := filepath.Abs(expandedPath)
+	if err != nil {
+		return expandedPath
+	}
+	return absolutePath
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestRequiredVolumes(t *testing.T) {
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error getting working directory: %s", err.Error())
+	}
+
+	cfg := newTestConfig()
+	cfg.Smartnode.DataPath.Value = "data"
+	cfg.Smartnode.CaBundlePath.Value = "/etc/ssl/corporate-ca.pem"
+
+	expected := []config.VolumeMount{
+		{HostPath: filepath.Join(workingDir, "data"), ContainerPath: DaemonDataPath, ReadOnly: false},
+		{HostPath: filepath.Join(workingDir, "data", validatorsFolder), ContainerPath: validatorsContainerPath, ReadOnly: false},
+		{HostPath: filepath.Join("/tmp/rocketpool", secretsFolder), ContainerPath: secretsContainerPath, ReadOnly: true},
+		{HostPath: "/etc/ssl/corporate-ca.pem", ContainerPath: caBundleContainerPath, ReadOnly: true},
+	}
+
+	volumes := cfg.RequiredVolumes()
+	if len(volumes) != len(expected) {
+		t.Fatalf("expected %d volumes, got %v", len(expected), volumes)
+	}
+	for i, volume := range volumes {
+		if !filepath.IsAbs(volume.HostPath) {
+			t.Errorf("expected the host path for %s to be absolute, got %s", volume.ContainerPath, volume.HostPath)
+		}
+		if volume != expected[i] {
+			t.Errorf("expected volume %d to be %+v, got %+v", i, expected[i], volume)
+		}
+	}
+
+	cfg.IsNativeMode = true
+	if volumes := cfg.RequiredVolumes(); len(volumes) != 0 {
+		t.Errorf("expected no volumes in Native mode, got %v", volumes)
+	}
+}
+
+func TestRequiredVolumesBackupFolder(t *testing.T) {
+	os.Setenv("RP_TEST_BACKUP_DISK", "/mnt/backup-disk")
+	defer os.Unsetenv("RP_TEST_BACKUP_DISK")
+
+	cfg := newTestConfig()
+	cfg.Smartnode.BackupSchedule.Value = "24h"
+	cfg.Smartnode.BackupPath.Value = "$RP_TEST_BACKUP_DISK/rocketpool"
+
+	volumes := cfg.RequiredVolumes()
+	backup := volumes[len(volumes)-1]
+	expected := config.VolumeMount{HostPath: "/mnt/backup-disk/rocketpool", ContainerPath: backupContainerPath, ReadOnly: false}
+	if backup != expected {
+		t.Errorf("expected the backup volume to be %+v, got %+v", expected, backup)
+	}
+	if backup.HostPath != cfg.Smartnode.GetBackupFolder(false) {
+		t.Errorf("expected the backup volume to match the backup folder %s, got %s", cfg.Smartnode.GetBackupFolder(false), backup.HostPath)
+	}
+}
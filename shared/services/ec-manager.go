@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"math"
 	"math/big"
-	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -435,6 +434,11 @@ func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{},
 				p.primaryReady = false
 				return p.runFunction(function)
 			}
+			if isRetryableError(err) && p.fallbackReady {
+				// If it's still connected but the request failed, retry just this request on the fallback
+				p.logger.Printlnf("WARNING: Primary Execution client request failed (%s), retrying on fallback...", err.Error())
+				return p.runFallbackFunction(function)
+			}
 
 			// If it's a different error, just return it
 			return nil, err
@@ -445,28 +449,34 @@ func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{},
 	}
 
 	if p.fallbackReady {
-		// Try to run the function on the fallback
-		result, err := function(p.fallbackEc)
-		if err != nil {
-			if p.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				p.logger.Printlnf("WARNING: Fallback Execution client disconnected (%s)", err.Error())
-				p.fallbackReady = false
-				return nil, fmt.Errorf("all Execution clients failed")
-			}
+		return p.runFallbackFunction(function)
+	}
 
-			// If it's a different error, just return it
-			return nil, err
+	return nil, fmt.Errorf("no Execution clients were ready")
+}
+
+// Runs a function on the fallback client
+func (p *ExecutionClientManager) runFallbackFunction(function ecFunction) (interface{}, error) {
+
+	// Try to run the function on the fallback
+	result, err := function(p.fallbackEc)
+	if err != nil {
+		if p.isDisconnected(err) {
+			// If it's disconnected, log it and mark the fallback as unavailable
+			p.logger.Printlnf("WARNING: Fallback Execution client disconnected (%s)", err.Error())
+			p.fallbackReady = false
+			return nil, fmt.Errorf("all Execution clients failed")
 		}
 
-		// If there's no error, return the result
-		return result, nil
+		// If it's a different error, just return it
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("no Execution clients were ready")
+	// If there's no error, return the result
+	return result, nil
 }
 
-// Returns true if the error was a connection failure and a backup client is available
+// Returns true if the error was a connection failure or timeout, so the client should be considered disconnected
 func (p *ExecutionClientManager) isDisconnected(err error) bool {
-	return strings.Contains(err.Error(), "dial tcp")
+	return isConnectionError(err)
 }
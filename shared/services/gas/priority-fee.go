@@ -0,0 +1,59 @@
+package gas
+
+import (
+	gomath "math"
+
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+// The priority fee (in gwei) to suggest for each urgency level when base fees are stable
+var basePriorityFeesGwei = map[string]float64{
+	"low":    1,
+	"medium": 2,
+	"high":   3,
+}
+
+// The urgency level to use if an unknown one is requested
+const defaultPriorityFeeUrgency string = "medium"
+
+// The most that base fee volatility can scale the suggested priority fee by
+const maxPriorityFeeVolatilityMultiplier float64 = 2
+
+// Suggest a priority fee (in gwei) based on how volatile the recent base fees have been and how urgent the transaction is
+// (low, medium, or high). When base fees are jumping around, blocks are full and competition for inclusion is higher, so the
+// suggestion is scaled up by the relative standard deviation of the base fees (up to a limit).
+func SuggestPriorityFee(recentBaseFeesGwei []float64, urgency string) float64 {
+	priorityFee, exists := basePriorityFeesGwei[urgency]
+	if !exists {
+		priorityFee = basePriorityFeesGwei[defaultPriorityFeeUrgency]
+	}
+
+	multiplier := 1 + getRelativeStandardDeviation(recentBaseFeesGwei)
+	if multiplier > maxPriorityFeeVolatilityMultiplier {
+		multiplier = maxPriorityFeeVolatilityMultiplier
+	}
+	return math.RoundUp(priorityFee*multiplier, 2)
+}
+
+// Get the standard deviation of a set of values as a fraction of their mean, or 0 if there aren't enough values to tell
+func getRelativeStandardDeviation(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+
+	mean := 0.0
+	for _, value := range values {
+		mean += value
+	}
+	mean /= float64(len(values))
+	if mean <= 0 {
+		return 0
+	}
+
+	variance := 0.0
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+	variance /= float64(len(values))
+	return gomath.Sqrt(variance) / mean
+}
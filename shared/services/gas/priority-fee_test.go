@@ -0,0 +1,32 @@
+package gas
+
+import (
+	"testing"
+)
+
+func TestSuggestPriorityFee(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseFees []float64
+		urgency  string
+		expected float64
+	}{
+		{"stable fees with low urgency", []float64{30, 30, 30}, "low", 1},
+		{"stable fees with medium urgency", []float64{30, 30, 30}, "medium", 2},
+		{"stable fees with high urgency", []float64{30, 30, 30}, "high", 3},
+		{"unknown urgency", []float64{30, 30, 30}, "urgent", 2},
+		{"not enough fees to measure volatility", []float64{30}, "medium", 2},
+		{"no fees", []float64{}, "medium", 2},
+		{"volatile fees", []float64{20, 40}, "medium", 2.67},
+		{"very volatile fees are capped", []float64{0, 0, 0, 100}, "medium", 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			priorityFee := SuggestPriorityFee(test.baseFees, test.urgency)
+			if priorityFee != test.expected {
+				t.Errorf("expected a priority fee of %v gwei, got %v", test.expected, priorityFee)
+			}
+		})
+	}
+}
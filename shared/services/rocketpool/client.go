@@ -93,6 +93,7 @@ type Client struct {
 	originalMaxPrioFee float64
 	originalGasLimit   uint64
 	debugPrint         bool
+	strictConfig       bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
 }
@@ -105,11 +106,12 @@ func NewClientFromCtx(c *cli.Context) (*Client, error) {
 		c.GlobalFloat64("maxPrioFee"),
 		c.GlobalUint64("gasLimit"),
 		c.GlobalString("nonce"),
-		c.GlobalBool("debug"))
+		c.GlobalBool("debug"),
+		c.GlobalBool("strict-config"))
 }
 
 // Create new Rocket Pool client
-func NewClient(configPath string, daemonPath string, maxFee float64, maxPrioFee float64, gasLimit uint64, customNonce string, debug bool) (*Client, error) {
+func NewClient(configPath string, daemonPath string, maxFee float64, maxPrioFee float64, gasLimit uint64, customNonce string, debug bool, strictConfig bool) (*Client, error) {
 
 	// Initialize SSH client if configured for SSH
 	var sshClient *ssh.Client
@@ -135,6 +137,7 @@ func NewClient(configPath string, daemonPath string, maxFee float64, maxPrioFee
 		customNonce:        customNonceBigInt,
 		client:             sshClient,
 		debugPrint:         debug,
+		strictConfig:       strictConfig,
 		forceFallbacks:     false,
 		ignoreSyncCheck:    false,
 	}
@@ -158,7 +161,8 @@ func (c *Client) LoadConfig() (*config.RocketPoolConfig, bool, error) {
 		return nil, false, fmt.Errorf("error expanding settings file path: %w", err)
 	}
 
-	cfg, err := rp.LoadConfigFromFile(expandedPath)
+	// Unrecognized settings are only an error in strict mode; otherwise they're ignored so command output stays clean
+	cfg, _, err := rp.LoadConfigFromFile(expandedPath, c.strictConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -179,7 +183,9 @@ func (c *Client) LoadBackupConfig() (*config.RocketPoolConfig, error) {
 		return nil, fmt.Errorf("error expanding backup settings file path: %w", err)
 	}
 
-	return rp.LoadConfigFromFile(expandedPath)
+	// Unrecognized settings in the backup will be dropped when it's saved again, so they're ignored here
+	cfg, _, err := rp.LoadConfigFromFile(expandedPath, false)
+	return cfg, err
 }
 
 // Save the config
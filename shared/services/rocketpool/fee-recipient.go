@@ -15,59 +15,79 @@ const (
 	FileMode fs.FileMode = 0644
 )
 
-// Checks if the fee recipient file exists and has the correct distributor address in it.
+// Checks if the fee recipient files exist and have the correct distributor address in them.
 // The first return value is for file existence, the second is for validation of the fee recipient address inside.
 func CheckFeeRecipientFile(feeRecipient common.Address, cfg *config.RocketPoolConfig) (bool, bool, error) {
 
-	// Check if the file exists
-	path := cfg.Smartnode.GetFeeRecipientFilePath()
-	_, err := os.Stat(path)
-	if os.IsNotExist(err) {
-		return false, false, nil
-	} else if err != nil {
+	// Compare the file contents with the expected string
+	expectedBytes, err := cfg.GenerateFeeRecipientFile(feeRecipient)
+	if err != nil {
 		return false, false, err
 	}
-
-	// Compare the file contents with the expected string
-	expectedString := getFeeRecipientFileContents(feeRecipient, cfg)
-	bytes, err := ioutil.ReadFile(path)
+	exists, correct, err := checkFile(cfg.Smartnode.GetFeeRecipientFilePath(), expectedBytes)
 	if err != nil {
 		return false, false, fmt.Errorf("error reading fee recipient file: %w", err)
 	}
-	existingString := string(bytes)
-	if existingString != expectedString {
-		// If it wrote properly, indicate a success but that the file needed to be updated
-		return true, false, nil
+	if !exists || !correct || !cfg.UsesTekuProposerConfig() {
+		return exists, correct, nil
 	}
 
-	// The file existed and had the expected address, all set.
-	return true, true, nil
+	// Teku also needs its proposer config file, which has the fee recipient and the builder registration overrides
+	expectedBytes, err = cfg.ConsensusCommon.GenerateTekuProposerConfig(feeRecipient, cfg.EnableMevBoost.Value == true)
+	if err != nil {
+		return false, false, err
+	}
+	exists, correct, err = checkFile(cfg.Smartnode.GetTekuProposerConfigFilePath(), expectedBytes)
+	if err != nil {
+		return false, false, fmt.Errorf("error reading Teku proposer config file: %w", err)
+	}
+	return exists, correct, nil
 }
 
-// Writes the given address to the fee recipient file. The VC should be restarted to pick up the new file.
+// Writes the given address to the fee recipient files. The VC should be restarted to pick up the new files.
 func UpdateFeeRecipientFile(feeRecipient common.Address, cfg *config.RocketPoolConfig) error {
 
 	// Create the distributor address string for the node
-	expectedString := getFeeRecipientFileContents(feeRecipient, cfg)
-	bytes := []byte(expectedString)
+	bytes, err := cfg.GenerateFeeRecipientFile(feeRecipient)
+	if err != nil {
+		return err
+	}
 
 	// Write the file
 	path := cfg.Smartnode.GetFeeRecipientFilePath()
-	err := ioutil.WriteFile(path, bytes, FileMode)
+	err = ioutil.WriteFile(path, bytes, FileMode)
 	if err != nil {
 		return fmt.Errorf("error writing fee recipient file: %w", err)
 	}
+
+	// Write Teku's proposer config file
+	if cfg.UsesTekuProposerConfig() {
+		bytes, err = cfg.ConsensusCommon.GenerateTekuProposerConfig(feeRecipient, cfg.EnableMevBoost.Value == true)
+		if err != nil {
+			return err
+		}
+		path = cfg.Smartnode.GetTekuProposerConfigFilePath()
+		err = ioutil.WriteFile(path, bytes, FileMode)
+		if err != nil {
+			return fmt.Errorf("error writing Teku proposer config file: %w", err)
+		}
+	}
 	return nil
 
 }
 
-// Gets the expected contents of the fee recipient file
-func getFeeRecipientFileContents(feeRecipient common.Address, cfg *config.RocketPoolConfig) string {
-	if !cfg.IsNativeMode {
-		// Docker mode
-		return feeRecipient.Hex()
+// Checks if a file exists and has the expected contents
+func checkFile(path string, expectedBytes []byte) (bool, bool, error) {
+	_, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, err
 	}
 
-	// Native mode
-	return fmt.Sprintf("%s=%s", config.FeeRecipientEnvVar, feeRecipient.Hex())
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, false, err
+	}
+	return true, string(bytes) == string(expectedBytes), nil
 }
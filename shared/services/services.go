@@ -1,8 +1,10 @@
 package services
 
 import (
+	"crypto/tls"
 	"fmt"
 	"math/big"
+	"net/http"
 	"os"
 	"sync"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+	lskeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lodestar"
 	nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
@@ -165,14 +168,40 @@ func getConfig(c *cli.Context) (*config.RocketPoolConfig, error) {
 	var err error
 	initCfg.Do(func() {
 		settingsFile := os.ExpandEnv(c.GlobalString("settings"))
-		cfg, err = rp.LoadConfigFromFile(settingsFile)
+		// Unrecognized settings are only rejected by the CLI in strict mode, so they're ignored here
+		cfg, _, err = rp.LoadConfigFromFile(settingsFile, false)
 		if cfg == nil && err == nil {
 			err = fmt.Errorf("Settings file [%s] not found.", settingsFile)
+			return
+		}
+		if err == nil {
+			err = configureCaBundle(cfg)
 		}
 	})
 	return cfg, err
 }
 
+// Adds the user's custom CA bundle (if one is set) to the certificate authorities trusted by the default HTTP transport,
+// which the Execution client, Beacon Node, and web3.storage clients all use
+func configureCaBundle(cfg *config.RocketPoolConfig) error {
+	caBundlePath := cfg.Smartnode.GetCaBundlePath(true)
+	if caBundlePath == "" {
+		return nil
+	}
+
+	pool, err := config.LoadCaBundle(caBundlePath)
+	if err != nil {
+		return fmt.Errorf("error loading custom CA bundle: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport)
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.RootCAs = pool
+	return nil
+}
+
 func getPasswordManager(cfg *config.RocketPoolConfig) *passwords.PasswordManager {
 	initPasswordManager.Do(func() {
 		passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
@@ -213,10 +242,12 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 		nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
 		prysmKeystore := prkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
 		tekuKeystore := tkkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
+		lodestarKeystore := lskeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
 		nodeWallet.AddKeystore("lighthouse", lighthouseKeystore)
 		nodeWallet.AddKeystore("nimbus", nimbusKeystore)
 		nodeWallet.AddKeystore("prysm", prysmKeystore)
 		nodeWallet.AddKeystore("teku", tekuKeystore)
+		nodeWallet.AddKeystore("lodestar", lodestarKeystore)
 	})
 	return nodeWallet, err
 }
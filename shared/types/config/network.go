@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The networks a node can run on; Network_All and Network_Unknown are placeholders, not real networks
+var knownNetworks = []Network{
+	Network_Mainnet,
+	Network_Prater,
+	Network_Devnet,
+}
+
+// Get the name of the network as it's written in config files, such as `mainnet`
+func (n Network) String() string {
+	return string(n)
+}
+
+// Parses the name of a network as it's written in config files (such as `mainnet`) into a Network.
+// Returns an error if the name isn't one of the networks a node can run on.
+func NetworkFromString(s string) (Network, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for _, network := range knownNetworks {
+		if string(network) == name {
+			return network, nil
+		}
+	}
+	return Network_Unknown, fmt.Errorf("[%s] is not a known network", s)
+}
@@ -0,0 +1,50 @@
+package config
+
+import "testing"
+
+func TestNetworkFromString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Network
+		valid    bool
+	}{
+		{"mainnet", "mainnet", Network_Mainnet, true},
+		{"prater", "prater", Network_Prater, true},
+		{"devnet", "devnet", Network_Devnet, true},
+		{"uppercase", "MAINNET", Network_Mainnet, true},
+		{"surrounding whitespace", " prater\n", Network_Prater, true},
+		{"blank", "", Network_Unknown, false},
+		{"all is a placeholder", "all", Network_Unknown, false},
+		{"unknown network", "goerli", Network_Unknown, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			network, err := NetworkFromString(test.input)
+			if test.valid && err != nil {
+				t.Fatalf("expected [%s] to parse, got error: %s", test.input, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Fatalf("expected [%s] to be rejected, got %s", test.input, network)
+			}
+			if network != test.expected {
+				t.Errorf("expected [%s] to parse as %s, got %s", test.input, test.expected, network)
+			}
+		})
+	}
+}
+
+func TestNetworkStringRoundTrip(t *testing.T) {
+	for _, network := range knownNetworks {
+		t.Run(string(network), func(t *testing.T) {
+			parsed, err := NetworkFromString(network.String())
+			if err != nil {
+				t.Fatalf("error parsing %s: %s", network.String(), err.Error())
+			}
+			if parsed != network {
+				t.Errorf("expected %s, got %s", network, parsed)
+			}
+		})
+	}
+}
@@ -2,28 +2,48 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 )
 
+// Compiled parameter regexes, keyed by their patterns, so they only need to be compiled once
+var regexCache = map[string]*regexp.Regexp{}
+var regexCacheLock sync.Mutex
+
+// The kinds of endpoint each common URL scheme is for, so HTTP and websocket URLs that are mixed up can be called out
+var urlSchemeKinds = map[string]string{
+	"http":  "an HTTP",
+	"https": "an HTTP",
+	"ws":    "a websocket",
+	"wss":   "a websocket",
+}
+
 // A parameter that can be configured by the user
 type Parameter struct {
-	ID                    string                  `yaml:"id,omitempty"`
-	Name                  string                  `yaml:"name,omitempty"`
-	Description           string                  `yaml:"description,omitempty"`
-	Type                  ParameterType           `yaml:"type,omitempty"`
-	Default               map[Network]interface{} `yaml:"default,omitempty"`
-	MaxLength             int                     `yaml:"maxLength,omitempty"`
-	Regex                 string                  `yaml:"regex,omitempty"`
-	Advanced              bool                    `yaml:"advanced,omitempty"`
-	AffectsContainers     []ContainerID           `yaml:"affectsContainers,omitempty"`
-	EnvironmentVariables  []string                `yaml:"environmentVariables,omitempty"`
-	CanBeBlank            bool                    `yaml:"canBeBlank,omitempty"`
-	OverwriteOnUpgrade    bool                    `yaml:"overwriteOnUpgrade,omitempty"`
-	Options               []ParameterOption       `yaml:"options,omitempty"`
-	Value                 interface{}             `yaml:"-"`
-	DescriptionsByNetwork map[Network]string      `yaml:"-"`
+	ID                    string                              `yaml:"id,omitempty"`
+	Name                  string                              `yaml:"name,omitempty"`
+	Description           string                              `yaml:"description,omitempty"`
+	Type                  ParameterType                       `yaml:"type,omitempty"`
+	Default               map[Network]interface{}             `yaml:"default,omitempty"`
+	MaxLength             int                                 `yaml:"maxLength,omitempty"`
+	Regex                 string                              `yaml:"regex,omitempty"`
+	Advanced              bool                                `yaml:"advanced,omitempty"`
+	AffectsContainers     []ContainerID                       `yaml:"affectsContainers,omitempty"`
+	EnvironmentVariables  []string                            `yaml:"environmentVariables,omitempty"`
+	CanBeBlank            bool                                `yaml:"canBeBlank,omitempty"`
+	OverwriteOnUpgrade    bool                                `yaml:"overwriteOnUpgrade,omitempty"`
+	Secret                bool                                `yaml:"secret,omitempty"`
+	MinValue              interface{}                         `yaml:"minValue,omitempty"`
+	MaxValue              interface{}                         `yaml:"maxValue,omitempty"`
+	UrlSchemes            []string                            `yaml:"urlSchemes,omitempty"`
+	NetworkConstraints    map[Network]func(interface{}) error `yaml:"-"`
+	Options               []ParameterOption                   `yaml:"options,omitempty"`
+	Value                 interface{}                         `yaml:"-"`
+	DescriptionsByNetwork map[Network]string                  `yaml:"-"`
 }
 
 // A single option in a choice parameter
@@ -56,6 +76,190 @@ func (param *Parameter) ChangeNetwork(oldNetwork Network, newNetwork Network) {
 	param.UpdateDescription(newNetwork)
 }
 
+// Check if the parameter has different defaults for different networks
+func (param *Parameter) IsNetworkSpecific() bool {
+	for network := range param.Default {
+		if network != Network_All {
+			return true
+		}
+	}
+	return false
+}
+
+// Checks a candidate value against the parameter's constraints: choice parameters must use one of their options, string
+// parameters must match their Regex and URL parameters must be well-formed URLs using one of their UrlSchemes, and numeric
+// parameters must be within their MinValue and MaxValue bounds, if they have any
+func (param *Parameter) Validate(value interface{}) error {
+	switch param.Type {
+	case ParameterType_Choice:
+		return param.validateChoice(value)
+	case ParameterType_String:
+		if len(param.UrlSchemes) > 0 {
+			err := param.validateUrl(value)
+			if err != nil {
+				return err
+			}
+		}
+		return param.validateRegex(value)
+	case ParameterType_Int, ParameterType_Uint, ParameterType_Uint16, ParameterType_Float:
+	default:
+		return nil
+	}
+	if param.MinValue == nil && param.MaxValue == nil {
+		return nil
+	}
+
+	number, err := getNumericValue(value)
+	if err != nil {
+		return fmt.Errorf("[%s] is not a number: %w", param.Name, err)
+	}
+
+	var min, max float64
+	if param.MinValue != nil {
+		min, err = getNumericValue(param.MinValue)
+		if err != nil {
+			return fmt.Errorf("[%s] has an invalid minimum value: %w", param.Name, err)
+		}
+	}
+	if param.MaxValue != nil {
+		max, err = getNumericValue(param.MaxValue)
+		if err != nil {
+			return fmt.Errorf("[%s] has an invalid maximum value: %w", param.Name, err)
+		}
+	}
+
+	switch {
+	case param.MinValue != nil && param.MaxValue != nil && (number < min || number > max):
+		return fmt.Errorf("[%s] must be between %v and %v", param.Name, param.MinValue, param.MaxValue)
+	case param.MinValue != nil && number < min:
+		return fmt.Errorf("[%s] must be at least %v", param.Name, param.MinValue)
+	case param.MaxValue != nil && number > max:
+		return fmt.Errorf("[%s] must be at most %v", param.Name, param.MaxValue)
+	}
+	return nil
+}
+
+// Checks a candidate value against the parameter's constraints, along with any extra rules it has for the given network
+func (param *Parameter) ValidateForNetwork(value interface{}, network Network) error {
+	err := param.Validate(value)
+	if err != nil {
+		return err
+	}
+
+	constraint, exists := param.NetworkConstraints[network]
+	if !exists {
+		return nil
+	}
+	err = constraint(value)
+	if err != nil {
+		return fmt.Errorf("[%s] is not valid on %s: %w", param.Name, network, err)
+	}
+	return nil
+}
+
+// Checks that a candidate value for a choice parameter is one of its options
+func (param *Parameter) validateChoice(value interface{}) error {
+	for _, option := range param.Options {
+		if option.Value == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("[%s] does not have an option for [%v]", param.Name, value)
+}
+
+// Checks that a candidate value for a string parameter matches its Regex, if it has one; blank values are left to CanBeBlank
+func (param *Parameter) validateRegex(value interface{}) error {
+	if param.Regex == "" || value == "" {
+		return nil
+	}
+	stringValue, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("[%s] is not a string", param.Name)
+	}
+
+	regex, err := getRegex(param.Regex)
+	if err != nil {
+		return fmt.Errorf("[%s] has an invalid format pattern: %w", param.Name, err)
+	}
+	if !regex.MatchString(stringValue) {
+		return fmt.Errorf("[%s] is set to %s, which is not in the expected format; please check the setting's description", param.Name, stringValue)
+	}
+	return nil
+}
+
+// Get the compiled form of a regex pattern, compiling and caching it if this is the first time it's been used
+func getRegex(pattern string) (*regexp.Regexp, error) {
+	regexCacheLock.Lock()
+	defer regexCacheLock.Unlock()
+
+	regex, exists := regexCache[pattern]
+	if exists {
+		return regex, nil
+	}
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexCache[pattern] = regex
+	return regex, nil
+}
+
+// Checks that a candidate value for a URL parameter has a host and uses one of the allowed schemes
+func (param *Parameter) validateUrl(value interface{}) error {
+	urlString, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("[%s] is not a string", param.Name)
+	}
+	if urlString == "" {
+		if param.CanBeBlank {
+			return nil
+		}
+		return fmt.Errorf("[%s] cannot be blank", param.Name)
+	}
+
+	parsedUrl, err := url.Parse(urlString)
+	if err != nil || parsedUrl.Host == "" || parsedUrl.Hostname() == "" {
+		return fmt.Errorf("[%s] is set to %s, which is not a valid URL; it must include the protocol and host, such as %s://192.168.1.10:8545", param.Name, urlString, param.UrlSchemes[0])
+	}
+	if port := parsedUrl.Port(); port != "" {
+		_, err = strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return fmt.Errorf("[%s] is set to %s, which does not have a valid port", param.Name, urlString)
+		}
+	}
+	for _, scheme := range param.UrlSchemes {
+		if parsedUrl.Scheme == scheme {
+			return nil
+		}
+	}
+	kind, isKnownKind := urlSchemeKinds[parsedUrl.Scheme]
+	expectedKind, isKnownExpectedKind := urlSchemeKinds[param.UrlSchemes[0]]
+	if isKnownKind && isKnownExpectedKind && kind != expectedKind {
+		return fmt.Errorf("[%s] is set to %s, which is %s URL; it must be %s URL that starts with %s://", param.Name, urlString, kind, expectedKind, strings.Join(param.UrlSchemes, ":// or "))
+	}
+	return fmt.Errorf("[%s] is set to %s, which uses an unsupported protocol; it must start with %s://", param.Name, urlString, strings.Join(param.UrlSchemes, ":// or "))
+}
+
+// Converts any of the numeric types a parameter can hold into a float64 for comparison
+func getNumericValue(value interface{}) (float64, error) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), nil
+	case int64:
+		return float64(number), nil
+	case uint:
+		return float64(number), nil
+	case uint64:
+		return float64(number), nil
+	case uint16:
+		return float64(number), nil
+	case float64:
+		return number, nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T", value)
+	}
+}
+
 // Serializes the parameter's value into a string
 func (param *Parameter) Serialize(serializedParams map[string]string) {
 	var value string
@@ -92,7 +296,10 @@ func (param *Parameter) Deserialize(serializedParams map[string]string, network
 		param.Value, err = strconv.ParseBool(value)
 	case ParameterType_String:
 		if param.Regex != "" {
-			regex := regexp.MustCompile(param.Regex)
+			regex, err := getRegex(param.Regex)
+			if err != nil {
+				return fmt.Errorf("cannot deserialize parameter [%s]: invalid format pattern: %w", param.ID, err)
+			}
 			if param.Value != "" && !regex.MatchString(value) {
 				return fmt.Errorf("cannot deserialize parameter [%s]: value [%s] did not match the expected format", param.ID, value)
 			}
@@ -167,9 +374,14 @@ func (param *Parameter) UpdateDescription(network Network) {
 // Add the parameters to the collection of environment variabes
 func AddParametersToEnvVars(params []*Parameter, envVars map[string]string) {
 	for _, param := range params {
+		// Unset values are still written (as blanks) so the compose files don't reference undefined variables
+		value := ""
+		if param.Value != nil {
+			value = fmt.Sprint(param.Value)
+		}
 		for _, envVar := range param.EnvironmentVariables {
 			if envVar != "" {
-				envVars[envVar] = fmt.Sprint(param.Value)
+				envVars[envVar] = value
 			}
 		}
 	}
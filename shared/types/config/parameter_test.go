@@ -0,0 +1,180 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParameterValidate(t *testing.T) {
+	gasLimit := Parameter{
+		Name:     "Gas Limit",
+		Type:     ParameterType_Uint,
+		MinValue: uint64(5000000),
+		MaxValue: uint64(100000000),
+	}
+	peers := Parameter{
+		Name:     "Max Peers",
+		Type:     ParameterType_Uint16,
+		MinValue: uint16(1),
+	}
+	fraction := Parameter{
+		Name:     "Fraction",
+		Type:     ParameterType_Float,
+		MinValue: float64(0),
+		MaxValue: float64(1),
+	}
+	unbounded := Parameter{
+		Name: "Unbounded",
+		Type: ParameterType_Int,
+	}
+	root := Parameter{
+		Name:       "Verification Root",
+		Type:       ParameterType_String,
+		Regex:      "^0x[0-9a-fA-F]{64}$",
+		CanBeBlank: true,
+	}
+	badPattern := Parameter{
+		Name:  "Bad Pattern",
+		Type:  ParameterType_String,
+		Regex: "([",
+	}
+	httpUrl := Parameter{
+		Name:       "HTTP URL",
+		Type:       ParameterType_String,
+		UrlSchemes: []string{"http", "https"},
+	}
+	mode := Parameter{
+		Name: "Mode",
+		Type: ParameterType_Choice,
+		Options: []ParameterOption{
+			{Name: "Local", Value: Mode_Local},
+			{Name: "External", Value: Mode_External},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		param *Parameter
+		value interface{}
+		valid bool
+	}{
+		{"uint within bounds", &gasLimit, uint64(30000000), true},
+		{"uint at minimum", &gasLimit, uint64(5000000), true},
+		{"uint at maximum", &gasLimit, uint64(100000000), true},
+		{"uint below minimum", &gasLimit, uint64(4999999), false},
+		{"uint above maximum", &gasLimit, uint64(100000001), false},
+		{"uint wrong type", &gasLimit, "30000000", false},
+		{"uint16 above minimum with no maximum", &peers, uint16(65535), true},
+		{"uint16 below minimum", &peers, uint16(0), false},
+		{"float within bounds", &fraction, float64(0.5), true},
+		{"float above maximum", &fraction, float64(1.01), false},
+		{"int with no bounds", &unbounded, int64(-100), true},
+		{"regex match", &root, "0x" + strings.Repeat("ab", 32), true},
+		{"regex mismatch", &root, "0x1234", false},
+		{"regex blank", &root, "", true},
+		{"regex wrong type", &root, 1234, false},
+		{"regex invalid pattern", &badPattern, "anything", false},
+		{"url with allowed scheme", &httpUrl, "http://192.168.1.10:8545", true},
+		{"url with websocket scheme", &httpUrl, "ws://192.168.1.10:8546", false},
+		{"url without scheme", &httpUrl, "192.168.1.10:8545", false},
+		{"url with invalid port", &httpUrl, "http://192.168.1.10:99999", false},
+		{"url blank when required", &httpUrl, "", false},
+		{"choice option", &mode, Mode_External, true},
+		{"choice not an option", &mode, Mode_Unknown, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.param.Validate(test.value)
+			if test.valid && err != nil {
+				t.Errorf("expected %v to be valid, got error: %s", test.value, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %v to be rejected", test.value)
+			}
+		})
+	}
+}
+
+func TestParameterValidateForNetwork(t *testing.T) {
+	param := Parameter{
+		Name: "Even Number",
+		Type: ParameterType_Uint,
+		NetworkConstraints: map[Network]func(interface{}) error{
+			Network_Mainnet: func(value interface{}) error {
+				if value.(uint64)%2 != 0 {
+					return errors.New("must be even")
+				}
+				return nil
+			},
+		},
+		MaxValue: uint64(100),
+	}
+
+	tests := []struct {
+		name    string
+		value   uint64
+		network Network
+		valid   bool
+	}{
+		{"passes constraint", 2, Network_Mainnet, true},
+		{"fails constraint", 3, Network_Mainnet, false},
+		{"no constraint on network", 3, Network_Prater, true},
+		{"fails base validation", 200, Network_Prater, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := param.ValidateForNetwork(test.value, test.network)
+			if test.valid && err != nil {
+				t.Errorf("expected %d to be valid on %s, got error: %s", test.value, test.network, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %d to be rejected on %s", test.value, test.network)
+			}
+		})
+	}
+}
+
+func TestParameterValidateUrlSchemeMismatch(t *testing.T) {
+	httpUrl := Parameter{
+		Name:       "HTTP URL",
+		Type:       ParameterType_String,
+		UrlSchemes: []string{"http", "https"},
+	}
+	wsUrl := Parameter{
+		Name:       "Websocket URL",
+		Type:       ParameterType_String,
+		UrlSchemes: []string{"ws", "wss"},
+	}
+
+	tests := []struct {
+		name    string
+		param   *Parameter
+		value   string
+		message string
+	}{
+		{"ws on an HTTP parameter", &httpUrl, "ws://192.168.1.10:8546", "which is a websocket URL"},
+		{"wss on an HTTP parameter", &httpUrl, "wss://192.168.1.10:8546", "which is a websocket URL"},
+		{"http on a websocket parameter", &wsUrl, "http://192.168.1.10:8545", "which is an HTTP URL"},
+		{"https on a websocket parameter", &wsUrl, "https://192.168.1.10:8545", "which is an HTTP URL"},
+		{"unrelated scheme", &wsUrl, "ftp://192.168.1.10:8545", "unsupported protocol"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.param.Validate(test.value)
+			if err == nil {
+				t.Fatalf("expected %s to be rejected", test.value)
+			}
+			if !strings.Contains(err.Error(), test.message) {
+				t.Errorf("expected the error to contain [%s], got [%s]", test.message, err.Error())
+			}
+		})
+	}
+
+	if err := wsUrl.Validate("wss://192.168.1.10:8546"); err != nil {
+		t.Errorf("expected a wss URL to be valid, got error: %s", err.Error())
+	}
+}
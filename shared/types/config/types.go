@@ -9,6 +9,12 @@ type ConsensusClient string
 type RewardsMode string
 type MevRelayID string
 type MevSelectionMode string
+type PortProtocol string
+type DeploymentType string
+type IssueSeverity string
+type UpdateChannel string
+type LogLevel string
+type EnvDriftType string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -61,6 +67,7 @@ const (
 	ExecutionClient_Geth       ExecutionClient = "geth"
 	ExecutionClient_Nethermind ExecutionClient = "nethermind"
 	ExecutionClient_Besu       ExecutionClient = "besu"
+	ExecutionClient_Erigon     ExecutionClient = "erigon"
 	ExecutionClient_Obs_Infura ExecutionClient = "infura"
 	ExecutionClient_Obs_Pocket ExecutionClient = "pocket"
 )
@@ -72,6 +79,7 @@ const (
 	ConsensusClient_Nimbus     ConsensusClient = "nimbus"
 	ConsensusClient_Prysm      ConsensusClient = "prysm"
 	ConsensusClient_Teku       ConsensusClient = "teku"
+	ConsensusClient_Lodestar   ConsensusClient = "lodestar"
 )
 
 // Enum to describe the rewards tree acquisition modes
@@ -98,6 +106,48 @@ const (
 	MevSelectionMode_Relay   MevSelectionMode = "relay"
 )
 
+// Enum to describe the transport protocol of an exposed port
+const (
+	PortProtocol_Tcp PortProtocol = "tcp"
+	PortProtocol_Udp PortProtocol = "udp"
+)
+
+// Enum to describe how the node is deployed, which informs which ports should be exposed
+const (
+	DeploymentType_Unknown   DeploymentType = ""
+	DeploymentType_Solo      DeploymentType = "solo"
+	DeploymentType_SharedLan DeploymentType = "shared"
+)
+
+// Enum to describe how serious a configuration issue is
+const (
+	IssueSeverity_Warning IssueSeverity = "warning"
+	IssueSeverity_Error   IssueSeverity = "error"
+)
+
+// Enum to describe which release channel the container images should come from
+const (
+	UpdateChannel_Stable  UpdateChannel = "stable"
+	UpdateChannel_Beta    UpdateChannel = "beta"
+	UpdateChannel_Nightly UpdateChannel = "nightly"
+)
+
+// Enum to describe the verbosity of a client's logs
+const (
+	LogLevel_Error LogLevel = "error"
+	LogLevel_Warn  LogLevel = "warn"
+	LogLevel_Info  LogLevel = "info"
+	LogLevel_Debug LogLevel = "debug"
+	LogLevel_Trace LogLevel = "trace"
+)
+
+// Enum to describe how an environment variable file differs from the configuration
+const (
+	EnvDriftType_Changed EnvDriftType = "changed"
+	EnvDriftType_Missing EnvDriftType = "missing"
+	EnvDriftType_Extra   EnvDriftType = "extra"
+)
+
 type Config interface {
 	GetConfigTitle() string
 	GetParameters() []*Parameter
@@ -136,3 +186,45 @@ type MevRelay struct {
 	Regulated     bool
 	NoSandwiching bool
 }
+
+// A port that is exposed outside of Docker
+type ExposedPort struct {
+	Port      uint16
+	Protocol  PortProtocol
+	Parameter *Parameter
+}
+
+// A problem found while checking a configuration
+type Issue struct {
+	Severity IssueSeverity
+	Message  string
+}
+
+// A host path that needs to be mounted into the Docker containers
+type VolumeMount struct {
+	HostPath      string
+	ContainerPath string
+	ReadOnly      bool
+}
+
+// A difference between an environment variable file and the values the configuration would generate
+type EnvDrift struct {
+	Name          string
+	Type          EnvDriftType
+	ExpectedValue string
+	ActualValue   string
+}
+
+// A parameter that can be set by path (`section.id`), for command-line completion
+type SettablePath struct {
+	Path    string
+	Type    ParameterType
+	Choices []string
+}
+
+// A validator's override of the default builder API registration settings
+type BuilderRegistrationOverride struct {
+	Pubkey   string
+	GasLimit uint64
+	Enabled  bool
+}
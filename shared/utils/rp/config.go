@@ -8,6 +8,7 @@ import (
 
 	"github.com/alessio/shellescape"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"gopkg.in/yaml.v2"
 )
 
@@ -15,19 +16,20 @@ const (
 	upgradeFlagFile string = ".firstrun"
 )
 
-// Loads a config without updating it if it exists
-func LoadConfigFromFile(path string) (*config.RocketPoolConfig, error) {
+// Loads a config without updating it if it exists. If strictMode is enabled, unrecognized settings cause an error; otherwise,
+// they're returned as warnings.
+func LoadConfigFromFile(path string, strictMode bool) (*config.RocketPoolConfig, []cfgtypes.Issue, error) {
 	_, err := os.Stat(path)
 	if os.IsNotExist(err) {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	cfg, err := config.LoadFromFile(path)
+	cfg, issues, err := config.LoadFromFile(path, strictMode)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return cfg, nil
+	return cfg, issues, nil
 }
 
 // Saves a config and removes the upgrade flag file
@@ -102,3 +102,33 @@ func GetFeeRecipientInfo(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddres
 	return info, nil
 
 }
+
+// Get the address the node's validators should currently be using as their fee recipient
+func (info *FeeRecipientInfo) GetCorrectFeeRecipient() common.Address {
+	if info.IsInSmoothingPool || info.IsInOptOutCooldown {
+		return info.SmoothingPoolAddress
+	}
+	return info.FeeDistributorAddress
+}
+
+// Checks that an address is the fee recipient the node's validators must use for the given Smoothing Pool opt-in state.
+// Validators that propose blocks with any other fee recipient are penalized, so this returns an error if it doesn't match.
+func (info *FeeRecipientInfo) ValidateFeeRecipient(address string, optedIn bool) error {
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("[%s] is not a valid address", address)
+	}
+	feeRecipient := common.HexToAddress(address)
+
+	// Nodes that just opted out have to keep using the Smoothing Pool until the opt-out epoch is finalized
+	if optedIn || info.IsInOptOutCooldown {
+		if feeRecipient != info.SmoothingPoolAddress {
+			return fmt.Errorf("the fee recipient %s is not the Smoothing Pool (%s); nodes in the Smoothing Pool will be penalized for proposing blocks with any other fee recipient", feeRecipient.Hex(), info.SmoothingPoolAddress.Hex())
+		}
+		return nil
+	}
+
+	if feeRecipient != info.FeeDistributorAddress {
+		return fmt.Errorf("the fee recipient %s is not the node's fee distributor (%s); nodes outside of the Smoothing Pool will be penalized for proposing blocks with any other fee recipient", feeRecipient.Hex(), info.FeeDistributorAddress.Hex())
+	}
+	return nil
+}
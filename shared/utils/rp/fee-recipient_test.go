@@ -0,0 +1,47 @@
+package rp
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestValidateFeeRecipient(t *testing.T) {
+	smoothingPool := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+	distributor := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	tests := []struct {
+		name       string
+		address    string
+		optedIn    bool
+		inCooldown bool
+		valid      bool
+	}{
+		{"opted in with the Smoothing Pool", smoothingPool.Hex(), true, false, true},
+		{"opted in with the fee distributor", distributor.Hex(), true, false, false},
+		{"opted out with the fee distributor", distributor.Hex(), false, false, true},
+		{"opted out with the Smoothing Pool", smoothingPool.Hex(), false, false, false},
+		{"opt-out cooldown with the Smoothing Pool", smoothingPool.Hex(), false, true, true},
+		{"opt-out cooldown with the fee distributor", distributor.Hex(), false, true, false},
+		{"lowercase address", "0xd4e96ef8eee8678dbff4d535e033ed1a4f7605b7", true, false, true},
+		{"invalid address", "0x1234", false, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info := &FeeRecipientInfo{
+				SmoothingPoolAddress:  smoothingPool,
+				FeeDistributorAddress: distributor,
+				IsInSmoothingPool:     test.optedIn,
+				IsInOptOutCooldown:    test.inCooldown,
+			}
+			err := info.ValidateFeeRecipient(test.address, test.optedIn)
+			if test.valid && err != nil {
+				t.Errorf("expected %s to be valid, got error: %s", test.address, err.Error())
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %s to be rejected", test.address)
+			}
+		})
+	}
+}